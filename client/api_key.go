@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateApiKey creates an api key and returns its ID and token. The token can
+// not be retrieved afterwards.
+func (c *chirpstack) CreateApiKey(ctx context.Context, apiKey *api.ApiKey) (string, string, error) {
+	resp, err := c.internalServiceClient.CreateApiKey(ctx, &api.CreateApiKeyRequest{
+		ApiKey: apiKey,
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to create api key %s; err: %w;", apiKey.Name, err)
+	}
+	return resp.Id, resp.Token, nil
+}
+
+// GetApiKey returns the admin api key, or the api key of the given tenant,
+// with the given ID. Chirpstack has no call to get a single api key, so the
+// keys are listed instead. A NotFound error is returned if the key does not
+// exist.
+func (c *chirpstack) GetApiKey(ctx context.Context, id string, isAdmin bool, tenantID string) (*api.ApiKey, error) {
+	for apiKey, err := range c.listApiKeys(ctx, isAdmin, tenantID) {
+		if err != nil {
+			return nil, err
+		}
+		if apiKey.Id == id {
+			return apiKey, nil
+		}
+	}
+	return nil, fmt.Errorf("failed to get api key %s; err: %w;", id, status.Error(codes.NotFound, "api key not found"))
+}
+
+func (c *chirpstack) DeleteApiKey(ctx context.Context, id string) error {
+	_, err := c.internalServiceClient.DeleteApiKey(ctx, &api.DeleteApiKeyRequest{
+		Id: id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete api key %s; err: %w;", id, err)
+	}
+	return nil
+}
+
+func (c *chirpstack) listApiKeys(ctx context.Context, isAdmin bool, tenantID string) iter.Seq2[*api.ApiKey, error] {
+	return paginate(0, func(offset, limit uint32) ([]*api.ApiKey, uint32, error) {
+		resp, err := c.internalServiceClient.ListApiKeys(ctx, &api.ListApiKeysRequest{
+			Limit:    limit,
+			Offset:   offset,
+			IsAdmin:  isAdmin,
+			TenantId: tenantID,
+		})
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to list api keys; err: %w;", err)
+		}
+		return resp.Result, resp.TotalCount, nil
+	})
+}
@@ -3,21 +3,38 @@ package client
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/chirpstack/chirpstack/api/go/v4/api"
 )
 
+// ListApplications returns up to limit applications of the tenant matching
+// name, walking as many pages as needed. A limit of 0 returns all matching
+// applications.
 func (c *chirpstack) ListApplications(ctx context.Context, tenantID, name string, limit uint32) ([]*api.ApplicationListItem, error) {
-	listApplicationsRequest := api.ListApplicationsRequest{
-		TenantId: tenantID,
-		Search:   name,
-		Limit:    limit,
-	}
-	listApplicationsResponse, listErr := c.applicationServiceClient.List(ctx, &listApplicationsRequest)
-	if listErr != nil {
-		return nil, fmt.Errorf("failed to list applications; err: %s;", listErr)
-	}
-	return listApplicationsResponse.Result, nil
+	return collect(c.listApplications(ctx, tenantID, name, limit))
+}
+
+// IterateApplications returns an iterator over all applications of the tenant
+// matching name.
+func (c *chirpstack) IterateApplications(ctx context.Context, tenantID, name string) iter.Seq2[*api.ApplicationListItem, error] {
+	return c.listApplications(ctx, tenantID, name, 0)
+}
+
+func (c *chirpstack) listApplications(ctx context.Context, tenantID, name string, limit uint32) iter.Seq2[*api.ApplicationListItem, error] {
+	return paginate(limit, func(offset, limit uint32) ([]*api.ApplicationListItem, uint32, error) {
+		listApplicationsRequest := api.ListApplicationsRequest{
+			TenantId: tenantID,
+			Search:   name,
+			Limit:    limit,
+			Offset:   offset,
+		}
+		listApplicationsResponse, listErr := c.applicationServiceClient.List(ctx, &listApplicationsRequest)
+		if listErr != nil {
+			return nil, 0, fmt.Errorf("failed to list applications; err: %w;", listErr)
+		}
+		return listApplicationsResponse.Result, listApplicationsResponse.TotalCount, nil
+	})
 }
 
 func (c *chirpstack) GetApplication(ctx context.Context, id string) (*api.Application, error) {
@@ -26,24 +43,20 @@ func (c *chirpstack) GetApplication(ctx context.Context, id string) (*api.Applic
 	}
 	resp, err := c.applicationServiceClient.Get(ctx, &req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get application %s; err: %s;", id, err)
+		return nil, fmt.Errorf("failed to get application %s; err: %w;", id, err)
 	}
 	return resp.Application, nil
 }
 
-func (c *chirpstack) CreateApplication(ctx context.Context, tenantId, name, description string) (string, error) {
-	createApplicationsRequest := api.CreateApplicationRequest{
-		Application: &api.Application{
-			TenantId:    tenantId,
-			Name:        name,
-			Description: description,
-		},
-	}
-	listApplicationsResponse, err := c.applicationServiceClient.Create(ctx, &createApplicationsRequest)
+// CreateApplication creates the application as given and returns its ID.
+func (c *chirpstack) CreateApplication(ctx context.Context, application *api.Application) (string, error) {
+	resp, err := c.applicationServiceClient.Create(ctx, &api.CreateApplicationRequest{
+		Application: application,
+	})
 	if err != nil {
-		return "", fmt.Errorf("failed to create application %s; err: %s;", name, err)
+		return "", fmt.Errorf("failed to create application %s; err: %w;", application.Name, err)
 	}
-	return listApplicationsResponse.Id, nil
+	return resp.Id, nil
 }
 
 func (c *chirpstack) UpdateApplication(ctx context.Context, application *api.Application) error {
@@ -52,7 +65,7 @@ func (c *chirpstack) UpdateApplication(ctx context.Context, application *api.App
 	}
 	_, err := c.applicationServiceClient.Update(ctx, &updateApplicationsRequest)
 	if err != nil {
-		return fmt.Errorf("failed to update application %s; err: %s;", application.Id, err)
+		return fmt.Errorf("failed to update application %s; err: %w;", application.Id, err)
 	}
 	return nil
 }
@@ -63,18 +76,31 @@ func (c *chirpstack) DeleteApplication(ctx context.Context, id string) error {
 	}
 	_, err := c.applicationServiceClient.Delete(ctx, &deleteApplicationsRequest)
 	if err != nil {
-		return fmt.Errorf("failed to delete application id %s; err: %s;", id, err)
+		return fmt.Errorf("failed to delete application id %s; err: %w;", id, err)
 	}
 	return nil
 }
 
+// GenerateMqttIntegrationCertificate issues a client certificate for the MQTT
+// integration of the application. Chirpstack does not store the certificate,
+// so it can not be retrieved or revoked afterwards.
+func (c *chirpstack) GenerateMqttIntegrationCertificate(ctx context.Context, applicationId string) (*api.GenerateMqttIntegrationClientCertificateResponse, error) {
+	resp, err := c.applicationServiceClient.GenerateMqttIntegrationClientCertificate(ctx, &api.GenerateMqttIntegrationClientCertificateRequest{
+		ApplicationId: applicationId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate mqtt integration certificate for application %s; err: %w;", applicationId, err)
+	}
+	return resp, nil
+}
+
 func (c *chirpstack) GetHttpIntegration(ctx context.Context, applicationId string) (*api.HttpIntegration, error) {
 	req := api.GetHttpIntegrationRequest{
 		ApplicationId: applicationId,
 	}
 	resp, err := c.applicationServiceClient.GetHttpIntegration(ctx, &req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get http integration for application id %s; err: %s;", applicationId, err)
+		return nil, fmt.Errorf("failed to get http integration for application id %s; err: %w;", applicationId, err)
 	}
 	return resp.Integration, nil
 }
@@ -84,7 +110,7 @@ func (c *chirpstack) CreateHttpIntegration(ctx context.Context, integration *api
 	}
 	_, err := c.applicationServiceClient.CreateHttpIntegration(ctx, &req)
 	if err != nil {
-		return fmt.Errorf("failed to create http integration %s; err: %s;", integration, err)
+		return fmt.Errorf("failed to create http integration %s; err: %w;", integration, err)
 	}
 	return nil
 }
@@ -94,7 +120,7 @@ func (c *chirpstack) UpdateHttpIntegration(ctx context.Context, integration *api
 	}
 	_, err := c.applicationServiceClient.UpdateHttpIntegration(ctx, &req)
 	if err != nil {
-		return fmt.Errorf("failed to update http integration %s; err: %s;", integration, err)
+		return fmt.Errorf("failed to update http integration %s; err: %w;", integration, err)
 	}
 	return nil
 }
@@ -104,7 +130,7 @@ func (c *chirpstack) DeleteHttpIntegration(ctx context.Context, applicationId st
 	}
 	_, err := c.applicationServiceClient.DeleteHttpIntegration(ctx, &req)
 	if err != nil {
-		return fmt.Errorf("failed to delete http integration for application id %s; err: %s;", applicationId, err)
+		return fmt.Errorf("failed to delete http integration for application id %s; err: %w;", applicationId, err)
 	}
 	return nil
 }
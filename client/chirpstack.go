@@ -2,16 +2,13 @@ package client
 
 import (
 	"context"
-	"crypto/tls"
-	"crypto/x509"
 	"fmt"
+	"iter"
 	"time"
 
 	"github.com/chirpstack/chirpstack/api/go/v4/api"
-	"github.com/chirpstack/chirpstack/api/go/v4/common"
 	"github.com/halter-corp/terraform-provider-chirpstack/client/model"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials"
 )
 
 type Chirpstack interface {
@@ -20,11 +17,24 @@ type Chirpstack interface {
 	GetTenant(ctx context.Context, id string) (*api.Tenant, error)
 	UpdateTenant(ctx context.Context, tenant *api.Tenant) error
 	DeleteTenant(ctx context.Context, id string) error
+	AddTenantUser(ctx context.Context, tenantUser *api.TenantUser) error
+	GetTenantUser(ctx context.Context, tenantID, userID string) (*api.TenantUser, error)
+	UpdateTenantUser(ctx context.Context, tenantUser *api.TenantUser) error
+	DeleteTenantUser(ctx context.Context, tenantID, userID string) error
 	ListTenants(ctx context.Context, name string, limit uint32) ([]*api.TenantListItem, error)
+	IterateTenants(ctx context.Context, name string) iter.Seq2[*api.TenantListItem, error]
+
+	// user
+	CreateUser(ctx context.Context, user *api.User, password string) (string, error)
+	GetUser(ctx context.Context, id string) (*api.User, error)
+	UpdateUser(ctx context.Context, user *api.User) error
+	UpdateUserPassword(ctx context.Context, id, password string) error
+	DeleteUser(ctx context.Context, id string) error
 
 	// application
 	ListApplications(ctx context.Context, tenantID, name string, limit uint32) ([]*api.ApplicationListItem, error)
-	CreateApplication(ctx context.Context, tenantID, name, description string) (string, error)
+	IterateApplications(ctx context.Context, tenantID, name string) iter.Seq2[*api.ApplicationListItem, error]
+	CreateApplication(ctx context.Context, application *api.Application) (string, error)
 	GetApplication(ctx context.Context, id string) (*api.Application, error)
 	UpdateApplication(ctx context.Context, application *api.Application) error
 	DeleteApplication(ctx context.Context, id string) error
@@ -34,6 +44,7 @@ type Chirpstack interface {
 	GetHttpIntegration(ctx context.Context, applicationId string) (*api.HttpIntegration, error)
 	UpdateHttpIntegration(ctx context.Context, integration *api.HttpIntegration) error
 	DeleteHttpIntegration(ctx context.Context, applicationId string) error
+	GenerateMqttIntegrationCertificate(ctx context.Context, applicationId string) (*api.GenerateMqttIntegrationClientCertificateResponse, error)
 
 	// messaging
 	Enqueue(ctx context.Context, request *api.EnqueueDeviceQueueItemRequest) (*api.EnqueueDeviceQueueItemResponse, error)
@@ -41,28 +52,66 @@ type Chirpstack interface {
 
 	// multicast group
 	ListMulticastGroups(ctx context.Context, applicationID, name string, limit uint32) ([]*api.MulticastGroupListItem, error)
+	IterateMulticastGroups(ctx context.Context, applicationID, name string) iter.Seq2[*api.MulticastGroupListItem, error]
 	GetMulticastGroup(ctx context.Context, id string) (*api.GetMulticastGroupResponse, error)
-	CreateMulticastGroup(ctx context.Context, applicationID, name string, region common.Region, mcAddr, mcNwkSKey, mcAppSKey string, fCnt uint32, dr, frequency uint32) error
+	CreateMulticastGroup(ctx context.Context, multicastGroup *api.MulticastGroup) (string, error)
+	UpdateMulticastGroup(ctx context.Context, multicastGroup *api.MulticastGroup) error
 	DeleteMulticastGroup(ctx context.Context, id string) error
 	AddGatewayToMulticastGroup(ctx context.Context, multicastGroupId, gatewayId string) error
 	RemoveGatewayFromMulticastGroup(ctx context.Context, multicastGroupId, gatewayId string) error
+	ListMulticastGroupGateways(ctx context.Context, multicastGroupId string) ([]*api.GatewayListItem, error)
+	AddDeviceToMulticastGroup(ctx context.Context, multicastGroupId, devEui string) error
+	RemoveDeviceFromMulticastGroup(ctx context.Context, multicastGroupId, devEui string) error
+	ListMulticastGroupDevices(ctx context.Context, multicastGroupId string) ([]*api.DeviceListItem, error)
 
 	// gateway
 	ListGateways(ctx context.Context, request *api.ListGatewaysRequest) ([]*api.GatewayListItem, error)
-	CreateGateway(ctx context.Context, gatewayEui, tenantID string, latitude, longitude, altitude float64, accuracy float32, statsInterval uint32) error
+	IterateGateways(ctx context.Context, tenantID, name string) iter.Seq2[*api.GatewayListItem, error]
+	CreateGateway(ctx context.Context, gateway *api.Gateway) error
+	GetGateway(ctx context.Context, gatewayId string) (*api.Gateway, error)
+	UpdateGateway(ctx context.Context, gateway *api.Gateway) error
+	DeleteGateway(ctx context.Context, gatewayId string) error
 
 	// device
 	ListDevices(ctx context.Context, applicationID, name string, limit uint32) ([]*api.DeviceListItem, error)
+	IterateDevices(ctx context.Context, applicationID, name string) iter.Seq2[*api.DeviceListItem, error]
 	GetDevice(ctx context.Context, deviceEui string) (*model.GetDeviceResponse, error)
-	CreateDevice(ctx context.Context, applicationID, deviceProfileID, deviceEui, name, joinEui, devAddr, appSKey, nwkSEncKey, appKey string) error
+	GetDeviceInfo(ctx context.Context, deviceEui string) (*api.Device, error)
+	CreateDevice(ctx context.Context, device *api.Device) error
+	UpdateDevice(ctx context.Context, device *api.Device) error
 	DeleteDevice(ctx context.Context, deviceEui string) error
 
+	// relay
+	AddRelayDevice(ctx context.Context, relayDevEui, devEui string) error
+	RemoveRelayDevice(ctx context.Context, relayDevEui, devEui string) error
+	ListRelayDevices(ctx context.Context, relayDevEui string) ([]*api.RelayDeviceListItem, error)
+
+	// device keys
+	CreateDeviceKeys(ctx context.Context, keys *api.DeviceKeys) error
+	GetDeviceKeys(ctx context.Context, deviceEui string) (*api.DeviceKeys, error)
+	UpdateDeviceKeys(ctx context.Context, keys *api.DeviceKeys) error
+	DeleteDeviceKeys(ctx context.Context, deviceEui string) error
+
+	// device activation
+	ActivateDevice(ctx context.Context, activation *api.DeviceActivation) error
+	DeactivateDevice(ctx context.Context, deviceEui string) error
+
 	// device profile
 	ListDeviceProfiles(ctx context.Context, tenantID, name string, limit uint32) ([]*api.DeviceProfileListItem, error)
+	IterateDeviceProfiles(ctx context.Context, tenantID, name string) iter.Seq2[*api.DeviceProfileListItem, error]
 	GetDeviceProfile(ctx context.Context, id string) (*api.DeviceProfile, error)
 	CreateDeviceProfile(ctx context.Context, deviceProfile *api.DeviceProfile) (string, error)
 	UpdateDeviceProfile(ctx context.Context, deviceProfile *api.DeviceProfile) error
 	DeleteDeviceProfile(ctx context.Context, id string) error
+
+	// region
+	ListRegions(ctx context.Context) ([]*api.RegionListItem, error)
+	GetRegion(ctx context.Context, id string) (*api.GetRegionResponse, error)
+
+	// api key
+	CreateApiKey(ctx context.Context, apiKey *api.ApiKey) (string, string, error)
+	GetApiKey(ctx context.Context, id string, isAdmin bool, tenantID string) (*api.ApiKey, error)
+	DeleteApiKey(ctx context.Context, id string) error
 }
 
 type apiToken string
@@ -80,59 +129,40 @@ func (a apiToken) RequireTransportSecurity() bool {
 	return false
 }
 
-func getCerts(host string, port int) ([]*x509.Certificate, error) {
-	conf := &tls.Config{
-		InsecureSkipVerify: true,
-	}
-
-	conn, err := tls.Dial("tcp", fmt.Sprintf("%s:%d", host, port), conf)
-	if err != nil {
-		return nil, err
-	}
-	defer func() {
-		_ = conn.Close()
-	}()
-	certs := conn.ConnectionState().PeerCertificates
-	return certs, nil
-}
-
-func loadTLSCredentials(host string, port int) (credentials.TransportCredentials, error) {
-	certs, err := getCerts(host, port)
-	if err != nil {
-		return nil, err
-	}
-
-	certPool := x509.NewCertPool()
-	for _, cert := range certs {
-		certPool.AddCert(cert)
-	}
-
-	// Create the credentials and return it
-	config := &tls.Config{
-		RootCAs: certPool,
-	}
-
-	return credentials.NewTLS(config), nil
-}
-
-func GetChirpstackConn(ctx context.Context, host string, port int, apiKey string) (grpc.ClientConnInterface, error) {
-	tlsCredentials, loadTLSCredErr := loadTLSCredentials(host, port)
+// GetChirpstackConn dials Chirpstack. All calls made through the connection
+// share the given request limits.
+func GetChirpstackConn(ctx context.Context, host string, port int, credentials Credentials, tlsConfig TLSConfig, retryPolicy RetryPolicy, limits RequestLimits) (grpc.ClientConnInterface, error) {
+	tlsCredentials, loadTLSCredErr := tlsConfig.transportCredentials()
 	if loadTLSCredErr != nil {
-		return nil, fmt.Errorf("cannot load TLS credentials: %v", loadTLSCredErr)
+		return nil, fmt.Errorf("cannot load TLS credentials: %w", loadTLSCredErr)
 	}
 
 	// debug issues with: export GRPC_GO_LOG_SEVERITY_LEVEL=info
 	optionList := []grpc.DialOption{
 		grpc.WithBlock(),
-		grpc.WithPerRPCCredentials(apiToken(apiKey)),
 		grpc.WithTransportCredentials(tlsCredentials),
 	}
+	// Authentication wraps the retries, so that a retried call reuses its
+	// session token and a rejected token is renewed only once.
+	optionList = append(optionList, credentials.dialOptions()...)
+	optionList = append(optionList, grpc.WithChainUnaryInterceptor(unaryInterceptors(retryPolicy, limits)...))
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 	conn, dialErr := grpc.DialContext(ctx, fmt.Sprintf("%s:%d", host, port), optionList...)
 	return conn, dialErr
 }
 
+// unaryInterceptors returns the interceptors applied to every call, outermost
+// first. The limits are applied inside the retries, so that every attempt is
+// limited and no slot is held while backing off.
+func unaryInterceptors(retryPolicy RetryPolicy, limits RequestLimits) []grpc.UnaryClientInterceptor {
+	interceptors := []grpc.UnaryClientInterceptor{retryPolicy.unaryInterceptor()}
+	if limiter := newLimiter(limits); limiter.enabled() {
+		interceptors = append(interceptors, limiter.unaryInterceptor())
+	}
+	return append(interceptors, retryPolicy.callTimeoutInterceptor())
+}
+
 type chirpstack struct {
 	tenantServiceClient         api.TenantServiceClient
 	applicationServiceClient    api.ApplicationServiceClient
@@ -140,6 +170,12 @@ type chirpstack struct {
 	deviceProfileServiceClient  api.DeviceProfileServiceClient
 	multicastGroupServiceClient api.MulticastGroupServiceClient
 	gatewayServiceClient        api.GatewayServiceClient
+	internalServiceClient       api.InternalServiceClient
+	userServiceClient           api.UserServiceClient
+	relayServiceClient          api.RelayServiceClient
+
+	multicastGroupDevices  memberCache[*api.DeviceListItem]
+	multicastGroupGateways memberCache[*api.GatewayListItem]
 }
 
 func NewChirpstack(conn grpc.ClientConnInterface) Chirpstack {
@@ -150,5 +186,8 @@ func NewChirpstack(conn grpc.ClientConnInterface) Chirpstack {
 		deviceProfileServiceClient:  api.NewDeviceProfileServiceClient(conn),
 		multicastGroupServiceClient: api.NewMulticastGroupServiceClient(conn),
 		gatewayServiceClient:        api.NewGatewayServiceClient(conn),
+		internalServiceClient:       api.NewInternalServiceClient(conn),
+		userServiceClient:           api.NewUserServiceClient(conn),
+		relayServiceClient:          api.NewRelayServiceClient(conn),
 	}
 }
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/chirpstack/chirpstack/api/go/v4/api"
 	"github.com/halter-corp/terraform-provider-chirpstack/client/model"
@@ -14,7 +15,7 @@ func (c *chirpstack) GetDevice(ctx context.Context, deviceEui string) (*model.Ge
 		DevEui: deviceEui,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get device from chirpstack; device: %+v; err: %+v;", getResp, err)
+		return nil, fmt.Errorf("failed to get device from chirpstack; device: %+v; err: %w;", getResp, err)
 	}
 	result.Device = getResp.Device
 	result.DeviceStatus = getResp.DeviceStatus
@@ -22,78 +23,148 @@ func (c *chirpstack) GetDevice(ctx context.Context, deviceEui string) (*model.Ge
 	getKeysResp, err := c.deviceServiceClient.GetKeys(ctx, &api.GetDeviceKeysRequest{
 		DevEui: deviceEui,
 	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to get keys for device chirpstack; keys %+v; err: %+v;", getKeysResp, err)
+	// ABP devices and devices whose keys are managed separately have no keys.
+	if err != nil && !IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get keys for device chirpstack; keys %+v; err: %w;", getKeysResp, err)
+	}
+	if err == nil {
+		result.DeviceKeys = getKeysResp.DeviceKeys
 	}
-	result.DeviceKeys = getKeysResp.DeviceKeys
 	getActivitionResp, err := c.deviceServiceClient.GetActivation(ctx, &api.GetDeviceActivationRequest{
 		DevEui: deviceEui,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get activation chirpstack; activation %+v; err: %+v;", getActivitionResp, err)
+		return nil, fmt.Errorf("failed to get activation chirpstack; activation %+v; err: %w;", getActivitionResp, err)
 	}
 	result.DeviceActivation = getActivitionResp.DeviceActivation
 	return &result, nil
 }
 
-func (c *chirpstack) CreateDevice(ctx context.Context, applicationID, deviceProfileID, deviceEui, name, joinEui, devAddr, appSKey, nwkSEncKey, appKey string) error {
-	device := api.Device{
-		DevEui:          deviceEui,
-		Name:            name,
-		JoinEui:         joinEui,
-		ApplicationId:   applicationID,
-		DeviceProfileId: deviceProfileID,
+// GetDeviceInfo returns the device only, without the keys and activation
+// returned by GetDevice, in a single call.
+func (c *chirpstack) GetDeviceInfo(ctx context.Context, deviceEui string) (*api.Device, error) {
+	resp, err := c.deviceServiceClient.Get(ctx, &api.GetDeviceRequest{
+		DevEui: deviceEui,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get device from chirpstack; dev eui: %s; err: %w;", deviceEui, err)
 	}
+	return resp.Device, nil
+}
+
+// CreateDevice creates the device only. Keys and activation are managed by
+// CreateDeviceKeys and ActivateDevice.
+func (c *chirpstack) CreateDevice(ctx context.Context, device *api.Device) error {
 	_, err := c.deviceServiceClient.Create(ctx, &api.CreateDeviceRequest{
-		Device: &device,
+		Device: device,
 	})
 	if err != nil {
-		return fmt.Errorf("failed to create device in chirpstack; device: %s; err: %+v;", device.String(), err)
+		return fmt.Errorf("failed to create device in chirpstack; device: %s; err: %w;", device.String(), err)
 	}
-	keys := api.DeviceKeys{
-		DevEui: deviceEui,
-		NwkKey: appKey,
+	return nil
+}
+
+func (c *chirpstack) UpdateDevice(ctx context.Context, device *api.Device) error {
+	_, err := c.deviceServiceClient.Update(ctx, &api.UpdateDeviceRequest{
+		Device: device,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update device in chirpstack; device: %s; err: %w;", device.String(), err)
 	}
-	_, err = c.deviceServiceClient.CreateKeys(ctx, &api.CreateDeviceKeysRequest{
-		DeviceKeys: &keys,
+	return nil
+}
+
+// ListDevices returns up to limit devices of the application matching name,
+// walking as many pages as needed. A limit of 0 returns all matching devices.
+func (c *chirpstack) ListDevices(ctx context.Context, applicationID, name string, limit uint32) ([]*api.DeviceListItem, error) {
+	return collect(c.listDevices(ctx, applicationID, "", name, limit))
+}
+
+// IterateDevices returns an iterator over all devices of the application
+// matching name.
+func (c *chirpstack) IterateDevices(ctx context.Context, applicationID, name string) iter.Seq2[*api.DeviceListItem, error] {
+	return c.listDevices(ctx, applicationID, "", name, 0)
+}
+
+func (c *chirpstack) listDevices(ctx context.Context, applicationID, multicastGroupID, name string, limit uint32) iter.Seq2[*api.DeviceListItem, error] {
+	return paginate(limit, func(offset, limit uint32) ([]*api.DeviceListItem, uint32, error) {
+		resp, err := c.deviceServiceClient.List(ctx, &api.ListDevicesRequest{
+			ApplicationId:    applicationID,
+			MulticastGroupId: multicastGroupID,
+			Search:           name,
+			Limit:            limit,
+			Offset:           offset,
+		})
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to list devices from chirpstack: %w", err)
+		}
+		return resp.Result, resp.TotalCount, nil
+	})
+}
+
+func (c *chirpstack) DeleteDevice(ctx context.Context, deviceEui string) error {
+	_, err := c.deviceServiceClient.Delete(ctx, &api.DeleteDeviceRequest{
+		DevEui: deviceEui,
 	})
+	return err
+}
 
+func (c *chirpstack) CreateDeviceKeys(ctx context.Context, keys *api.DeviceKeys) error {
+	_, err := c.deviceServiceClient.CreateKeys(ctx, &api.CreateDeviceKeysRequest{
+		DeviceKeys: keys,
+	})
 	if err != nil {
-		return fmt.Errorf("failed to create keys for device chirpstack; keys %s; err: %+v;", keys.String(), err)
+		return fmt.Errorf("failed to create keys for device in chirpstack; dev eui: %s; err: %w;", keys.DevEui, err)
 	}
-	activation := api.DeviceActivation{
-		DevEui:      deviceEui,
-		DevAddr:     devAddr,
-		AppSKey:     appSKey,
-		NwkSEncKey:  nwkSEncKey,
-		SNwkSIntKey: nwkSEncKey,
-		FNwkSIntKey: nwkSEncKey,
+	return nil
+}
+
+func (c *chirpstack) GetDeviceKeys(ctx context.Context, deviceEui string) (*api.DeviceKeys, error) {
+	resp, err := c.deviceServiceClient.GetKeys(ctx, &api.GetDeviceKeysRequest{
+		DevEui: deviceEui,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get keys for device from chirpstack; dev eui: %s; err: %w;", deviceEui, err)
 	}
-	_, err = c.deviceServiceClient.Activate(ctx, &api.ActivateDeviceRequest{
-		DeviceActivation: &activation,
+	return resp.DeviceKeys, nil
+}
+
+func (c *chirpstack) UpdateDeviceKeys(ctx context.Context, keys *api.DeviceKeys) error {
+	_, err := c.deviceServiceClient.UpdateKeys(ctx, &api.UpdateDeviceKeysRequest{
+		DeviceKeys: keys,
 	})
+	if err != nil {
+		return fmt.Errorf("failed to update keys for device in chirpstack; dev eui: %s; err: %w;", keys.DevEui, err)
+	}
+	return nil
+}
 
+func (c *chirpstack) DeleteDeviceKeys(ctx context.Context, deviceEui string) error {
+	_, err := c.deviceServiceClient.DeleteKeys(ctx, &api.DeleteDeviceKeysRequest{
+		DevEui: deviceEui,
+	})
 	if err != nil {
-		return fmt.Errorf("failed to activate device chirpstack; activation %s; err: %v;", activation.String(), err)
+		return fmt.Errorf("failed to delete keys for device in chirpstack; dev eui: %s; err: %w;", deviceEui, err)
 	}
 	return nil
 }
 
-func (c *chirpstack) ListDevices(ctx context.Context, applicationID, name string, limit uint32) ([]*api.DeviceListItem, error) {
-	resp, err := c.deviceServiceClient.List(ctx, &api.ListDevicesRequest{
-		ApplicationId: applicationID,
-		Search:        name,
-		Limit:         limit,
+func (c *chirpstack) ActivateDevice(ctx context.Context, activation *api.DeviceActivation) error {
+	_, err := c.deviceServiceClient.Activate(ctx, &api.ActivateDeviceRequest{
+		DeviceActivation: activation,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to list devices from chirpstack: %v", err)
+		return fmt.Errorf("failed to activate device in chirpstack; dev eui: %s; err: %w;", activation.DevEui, err)
 	}
-	return resp.Result, nil
+	return nil
 }
 
-func (c *chirpstack) DeleteDevice(ctx context.Context, deviceEui string) error {
-	_, err := c.deviceServiceClient.Delete(ctx, &api.DeleteDeviceRequest{
+func (c *chirpstack) DeactivateDevice(ctx context.Context, deviceEui string) error {
+	_, err := c.deviceServiceClient.Deactivate(ctx, &api.DeactivateDeviceRequest{
 		DevEui: deviceEui,
 	})
-	return err
+	if err != nil {
+		return fmt.Errorf("failed to deactivate device in chirpstack; dev eui: %s; err: %w;", deviceEui, err)
+	}
+	return nil
 }
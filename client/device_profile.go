@@ -3,20 +3,37 @@ package client
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/chirpstack/chirpstack/api/go/v4/api"
 )
 
+// ListDeviceProfiles returns up to limit device profiles of the tenant
+// matching name, walking as many pages as needed. A limit of 0 returns all
+// matching device profiles.
 func (c *chirpstack) ListDeviceProfiles(ctx context.Context, tenantID, name string, limit uint32) ([]*api.DeviceProfileListItem, error) {
-	resp, err := c.deviceProfileServiceClient.List(ctx, &api.ListDeviceProfilesRequest{
-		TenantId: tenantID,
-		Search:   name,
-		Limit:    limit,
+	return collect(c.listDeviceProfiles(ctx, tenantID, name, limit))
+}
+
+// IterateDeviceProfiles returns an iterator over all device profiles of the
+// tenant matching name.
+func (c *chirpstack) IterateDeviceProfiles(ctx context.Context, tenantID, name string) iter.Seq2[*api.DeviceProfileListItem, error] {
+	return c.listDeviceProfiles(ctx, tenantID, name, 0)
+}
+
+func (c *chirpstack) listDeviceProfiles(ctx context.Context, tenantID, name string, limit uint32) iter.Seq2[*api.DeviceProfileListItem, error] {
+	return paginate(limit, func(offset, limit uint32) ([]*api.DeviceProfileListItem, uint32, error) {
+		resp, err := c.deviceProfileServiceClient.List(ctx, &api.ListDeviceProfilesRequest{
+			TenantId: tenantID,
+			Search:   name,
+			Limit:    limit,
+			Offset:   offset,
+		})
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to list device profiles from chirpstack: %w", err)
+		}
+		return resp.Result, resp.TotalCount, nil
 	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to list device profiles from chirpstack: %v", err)
-	}
-	return resp.Result, nil
 }
 
 func (c *chirpstack) GetDeviceProfile(ctx context.Context, id string) (*api.DeviceProfile, error) {
@@ -25,7 +42,7 @@ func (c *chirpstack) GetDeviceProfile(ctx context.Context, id string) (*api.Devi
 	}
 	resp, err := c.deviceProfileServiceClient.Get(ctx, &req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get device profile %s; err: %s;", id, err)
+		return nil, fmt.Errorf("failed to get device profile %s; err: %w;", id, err)
 	}
 	return resp.DeviceProfile, nil
 }
@@ -36,7 +53,7 @@ func (c *chirpstack) CreateDeviceProfile(ctx context.Context, deviceProfile *api
 	}
 	listDeviceProfilesResponse, err := c.deviceProfileServiceClient.Create(ctx, &createDeviceProfilesRequest)
 	if err != nil {
-		return "", fmt.Errorf("failed to create device profile: %+v; err: %s;", deviceProfile, err)
+		return "", fmt.Errorf("failed to create device profile: %+v; err: %w;", deviceProfile, err)
 	}
 	return listDeviceProfilesResponse.Id, nil
 }
@@ -47,7 +64,7 @@ func (c *chirpstack) UpdateDeviceProfile(ctx context.Context, deviceProfile *api
 	}
 	_, err := c.deviceProfileServiceClient.Update(ctx, &updateDeviceProfilesRequest)
 	if err != nil {
-		return fmt.Errorf("failed to update device profile %s; err: %s;", deviceProfile.Id, err)
+		return fmt.Errorf("failed to update device profile %s; err: %w;", deviceProfile.Id, err)
 	}
 	return nil
 }
@@ -58,7 +75,7 @@ func (c *chirpstack) DeleteDeviceProfile(ctx context.Context, id string) error {
 	}
 	_, err := c.deviceProfileServiceClient.Delete(ctx, &deleteDeviceProfilesRequest)
 	if err != nil {
-		return fmt.Errorf("failed to delete device profile id %s; err: %s;", id, err)
+		return fmt.Errorf("failed to delete device profile id %s; err: %w;", id, err)
 	}
 	return nil
 }
@@ -0,0 +1,12 @@
+package client
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// IsNotFound reports whether err, or any error it wraps, is a gRPC NotFound
+// error returned by ChirpStack.
+func IsNotFound(err error) bool {
+	return status.Code(err) == codes.NotFound
+}
@@ -2,45 +2,78 @@ package client
 
 import (
 	"context"
+	"fmt"
+	"iter"
 
 	"github.com/chirpstack/chirpstack/api/go/v4/api"
-	"github.com/chirpstack/chirpstack/api/go/v4/common"
 )
 
-func (c *chirpstack) CreateGateway(ctx context.Context, gatewayEui, tenantID string, latitude, longitude, altitude float64, accuracy float32, statsInterval uint32) error {
-	gw := api.Gateway{
-		GatewayId:   gatewayEui,
-		Name:        "eui-" + gatewayEui,
-		Description: "eui-" + gatewayEui,
-		TenantId:    tenantID,
-		Location: &common.Location{
-			Latitude:  latitude,
-			Longitude: longitude,
-			Altitude:  altitude,
-			Accuracy:  accuracy,
-		},
-		StatsInterval: statsInterval,
+// CreateGateway creates the gateway. An existing gateway is never updated, so
+// that create errors are reported.
+func (c *chirpstack) CreateGateway(ctx context.Context, gateway *api.Gateway) error {
+	_, err := c.gatewayServiceClient.Create(ctx, &api.CreateGatewayRequest{
+		Gateway: gateway,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create gateway in chirpstack; gateway: %s; err: %w;", gateway.String(), err)
 	}
+	return nil
+}
 
-	_, err := c.gatewayServiceClient.Create(ctx, &api.CreateGatewayRequest{
-		Gateway: &gw,
+func (c *chirpstack) GetGateway(ctx context.Context, gatewayId string) (*api.Gateway, error) {
+	resp, err := c.gatewayServiceClient.Get(ctx, &api.GetGatewayRequest{
+		GatewayId: gatewayId,
 	})
 	if err != nil {
-		_, err = c.gatewayServiceClient.Update(ctx, &api.UpdateGatewayRequest{
-			Gateway: &gw,
-		})
-		if err != nil {
-			return err
-		}
+		return nil, fmt.Errorf("failed to get gateway from chirpstack; gateway id: %s; err: %w;", gatewayId, err)
 	}
+	return resp.Gateway, nil
+}
 
+func (c *chirpstack) UpdateGateway(ctx context.Context, gateway *api.Gateway) error {
+	_, err := c.gatewayServiceClient.Update(ctx, &api.UpdateGatewayRequest{
+		Gateway: gateway,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update gateway in chirpstack; gateway: %s; err: %w;", gateway.String(), err)
+	}
 	return nil
 }
 
-func (c *chirpstack) ListGateways(ctx context.Context, request *api.ListGatewaysRequest) ([]*api.GatewayListItem, error) {
-	resp, err := c.gatewayServiceClient.List(ctx, request)
+func (c *chirpstack) DeleteGateway(ctx context.Context, gatewayId string) error {
+	_, err := c.gatewayServiceClient.Delete(ctx, &api.DeleteGatewayRequest{
+		GatewayId: gatewayId,
+	})
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("failed to delete gateway in chirpstack; gateway id: %s; err: %w;", gatewayId, err)
 	}
-	return resp.Result, nil
+	return nil
+}
+
+// ListGateways returns up to request.Limit gateways matching the request,
+// walking as many pages as needed. A limit of 0 returns all matching gateways.
+func (c *chirpstack) ListGateways(ctx context.Context, request *api.ListGatewaysRequest) ([]*api.GatewayListItem, error) {
+	return collect(c.listGateways(ctx, request.TenantId, request.MulticastGroupId, request.Search, request.Limit))
+}
+
+// IterateGateways returns an iterator over all gateways matching name. When
+// tenantID is empty, the gateways of all tenants are returned.
+func (c *chirpstack) IterateGateways(ctx context.Context, tenantID, name string) iter.Seq2[*api.GatewayListItem, error] {
+	return c.listGateways(ctx, tenantID, "", name, 0)
+}
+
+func (c *chirpstack) listGateways(ctx context.Context, tenantID, multicastGroupID, name string, limit uint32) iter.Seq2[*api.GatewayListItem, error] {
+	return paginate(limit, func(offset, limit uint32) ([]*api.GatewayListItem, uint32, error) {
+		resp, err := c.gatewayServiceClient.List(ctx, &api.ListGatewaysRequest{
+			TenantId:         tenantID,
+			MulticastGroupId: multicastGroupID,
+			Search:           name,
+			Limit:            limit,
+			Offset:           offset,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Result, resp.TotalCount, nil
+	})
 }
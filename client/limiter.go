@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+)
+
+// RequestLimits limits the load the provider puts on ChirpStack. The limits
+// are shared by all resources and data sources using the same client.
+type RequestLimits struct {
+	// MaxRequestsPerSecond is the maximum sustained rate of calls. 0 means
+	// unlimited.
+	MaxRequestsPerSecond float64
+	// MaxConcurrentRequests is the maximum number of calls in flight. 0 means
+	// unlimited.
+	MaxConcurrentRequests int
+}
+
+// limiter enforces RequestLimits.
+type limiter struct {
+	rate  *rate.Limiter
+	slots chan struct{}
+}
+
+func newLimiter(limits RequestLimits) *limiter {
+	l := &limiter{}
+	if limits.MaxRequestsPerSecond > 0 {
+		l.rate = rate.NewLimiter(rate.Limit(limits.MaxRequestsPerSecond), 1)
+	}
+	if limits.MaxConcurrentRequests > 0 {
+		l.slots = make(chan struct{}, limits.MaxConcurrentRequests)
+	}
+	return l
+}
+
+func (l *limiter) enabled() bool {
+	return l.rate != nil || l.slots != nil
+}
+
+// acquire waits until a call may start, and returns a function that must be
+// called once the call has finished.
+func (l *limiter) acquire(ctx context.Context, method string) (func(), error) {
+	start := time.Now()
+	release := func() {}
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+			release = func() { <-l.slots }
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if l.rate != nil {
+		if err := l.rate.Wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	tflog.Debug(ctx, "Chirpstack call dequeued", map[string]interface{}{
+		"method":        method,
+		"queue_wait_ms": time.Since(start).Milliseconds(),
+		"in_flight":     len(l.slots),
+	})
+	return release, nil
+}
+
+// unaryInterceptor returns a gRPC interceptor applying the limits to every
+// attempt of a unary call. It must run inside the retry interceptor, so that
+// retries are limited as well and no slot is held while backing off.
+func (l *limiter) unaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		release, err := l.acquire(ctx, method)
+		if err != nil {
+			return err
+		}
+		defer release()
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,113 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// invokeChain calls invoker through the interceptors, outermost first, the
+// same way grpc.WithChainUnaryInterceptor does.
+func invokeChain(ctx context.Context, interceptors []grpc.UnaryClientInterceptor, invoker grpc.UnaryInvoker) error {
+	if len(interceptors) == 0 {
+		return invoker(ctx, "/test/Call", nil, nil, nil)
+	}
+	next := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		return invokeChain(ctx, interceptors[1:], invoker)
+	}
+	return interceptors[0](ctx, "/test/Call", nil, nil, nil, next)
+}
+
+func TestLimitsApplyToEveryAttempt(t *testing.T) {
+	retryPolicy := RetryPolicy{
+		MaxAttempts:    3,
+		RetryableCodes: []codes.Code{codes.Unavailable},
+	}
+	// A single token every 100ms, so every attempt after the first waits.
+	interceptors := unaryInterceptors(retryPolicy, RequestLimits{MaxRequestsPerSecond: 10})
+
+	var attempts int
+	start := time.Now()
+	err := invokeChain(context.Background(), interceptors, func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		attempts++
+		return status.Error(codes.Unavailable, "unavailable")
+	})
+	elapsed := time.Since(start)
+
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("expected an Unavailable error, got: %v", err)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts)
+	}
+	if elapsed < 150*time.Millisecond {
+		t.Fatalf("expected the retries to wait for the rate limit, the call took %s", elapsed)
+	}
+}
+
+func TestLimitsReleaseSlotWhileBackingOff(t *testing.T) {
+	retryPolicy := RetryPolicy{
+		MaxAttempts:    2,
+		InitialBackoff: 400 * time.Millisecond,
+		MaxBackoff:     400 * time.Millisecond,
+		RetryableCodes: []codes.Code{codes.Unavailable},
+	}
+	interceptors := unaryInterceptors(retryPolicy, RequestLimits{MaxConcurrentRequests: 1})
+
+	var inFlight, maxInFlight, attempts atomic.Int32
+	var mu sync.Mutex
+	var order []string
+	invoker := func(name string, failures int32) grpc.UnaryInvoker {
+		var calls atomic.Int32
+		return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			attempts.Add(1)
+			current := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				seen := maxInFlight.Load()
+				if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+					break
+				}
+			}
+			mu.Lock()
+			order = append(order, name)
+			mu.Unlock()
+			if calls.Add(1) <= failures {
+				return status.Error(codes.Unavailable, "unavailable")
+			}
+			return nil
+		}
+	}
+
+	// The first call fails once and backs off, the second call must be able
+	// to use the slot in the meantime.
+	first := make(chan error)
+	go func() {
+		first <- invokeChain(context.Background(), interceptors, invoker("first", 1))
+	}()
+	time.Sleep(100 * time.Millisecond)
+	if err := invokeChain(context.Background(), interceptors, invoker("second", 0)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := <-first; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := attempts.Load(); got != 3 {
+		t.Fatalf("expected 3 attempts, got %d", got)
+	}
+	if got := maxInFlight.Load(); got != 1 {
+		t.Fatalf("expected at most 1 attempt in flight, got %d", got)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(order) != 3 || order[0] != "first" || order[1] != "second" || order[2] != "first" {
+		t.Fatalf("expected the second call to run while the first call backs off, got order %v", order)
+	}
+}
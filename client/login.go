@@ -0,0 +1,121 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// tokenRefreshMargin is how long before its expiry a session token is
+// replaced, so that calls in flight do not fail on an expired token.
+const tokenRefreshMargin = time.Minute
+
+// Credentials authenticate the provider against ChirpStack. Either APIKey, or
+// Email and Password must be set.
+type Credentials struct {
+	APIKey   string
+	Email    string
+	Password string
+}
+
+func (c Credentials) dialOptions() []grpc.DialOption {
+	if c.Email == "" {
+		return []grpc.DialOption{grpc.WithPerRPCCredentials(apiToken(c.APIKey))}
+	}
+	session := &loginSession{email: c.Email, password: c.Password}
+	return []grpc.DialOption{grpc.WithChainUnaryInterceptor(session.unaryInterceptor())}
+}
+
+// loginSession authenticates calls with a JWT obtained from
+// InternalService.Login, and logs in again when the token expires.
+type loginSession struct {
+	email    string
+	password string
+
+	mu        sync.Mutex
+	jwt       string
+	expiresAt time.Time
+}
+
+func (s *loginSession) unaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if method == api.InternalService_Login_FullMethodName {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		jwt, err := s.token(ctx, cc, "")
+		if err != nil {
+			return err
+		}
+		err = invoker(withBearer(ctx, jwt), method, req, reply, cc, opts...)
+		if status.Code(err) != codes.Unauthenticated {
+			return err
+		}
+
+		// The token was rejected before its expiry, e.g. because the server
+		// was restarted with a new secret. Log in again once.
+		jwt, err = s.token(ctx, cc, jwt)
+		if err != nil {
+			return err
+		}
+		return invoker(withBearer(ctx, jwt), method, req, reply, cc, opts...)
+	}
+}
+
+// token returns a valid session token, logging in if there is none yet, if it
+// is about to expire, or if it is the rejected token.
+func (s *loginSession) token(ctx context.Context, cc grpc.ClientConnInterface, rejected string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.jwt != "" && s.jwt != rejected && (s.expiresAt.IsZero() || time.Until(s.expiresAt) > tokenRefreshMargin) {
+		return s.jwt, nil
+	}
+
+	tflog.Debug(ctx, "Logging in to Chirpstack", map[string]interface{}{"email": s.email})
+	resp, err := api.NewInternalServiceClient(cc).Login(ctx, &api.LoginRequest{
+		Email:    s.email,
+		Password: s.password,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to login; email: %s, err: %w;", s.email, err)
+	}
+	s.jwt = resp.Jwt
+	s.expiresAt = tokenExpiry(resp.Jwt)
+	return s.jwt, nil
+}
+
+func withBearer(ctx context.Context, jwt string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+jwt)
+}
+
+// tokenExpiry returns the expiry time of a JWT, or the zero time if the token
+// does not carry one. The signature is not verified, the server does that.
+func tokenExpiry(jwt string) time.Time {
+	parts := strings.Split(jwt, ".")
+	if len(parts) != 3 {
+		return time.Time{}
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}
+	}
+	return time.Unix(claims.Exp, 0)
+}
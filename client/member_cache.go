@@ -0,0 +1,55 @@
+package client
+
+import (
+	"sync"
+)
+
+// memberCache caches the members of multicast groups per group ID, so that
+// refreshing many memberships of the same group lists its members only once
+// instead of once per membership. Entries must be invalidated when the members
+// of a group change.
+type memberCache[T any] struct {
+	mu      sync.Mutex
+	entries map[string]*memberCacheEntry[T]
+}
+
+type memberCacheEntry[T any] struct {
+	once    sync.Once
+	members []T
+	err     error
+}
+
+// get returns the cached members of the group, calling list on a cache miss.
+// Concurrent callers for the same group share a single call of list. Errors
+// are not cached.
+func (c *memberCache[T]) get(multicastGroupId string, list func() ([]T, error)) ([]T, error) {
+	c.mu.Lock()
+	if c.entries == nil {
+		c.entries = map[string]*memberCacheEntry[T]{}
+	}
+	entry, ok := c.entries[multicastGroupId]
+	if !ok {
+		entry = &memberCacheEntry[T]{}
+		c.entries[multicastGroupId] = entry
+	}
+	c.mu.Unlock()
+
+	entry.once.Do(func() {
+		entry.members, entry.err = list()
+	})
+	if entry.err != nil {
+		c.mu.Lock()
+		if c.entries[multicastGroupId] == entry {
+			delete(c.entries, multicastGroupId)
+		}
+		c.mu.Unlock()
+	}
+	return entry.members, entry.err
+}
+
+// invalidate drops the cached members of the group.
+func (c *memberCache[T]) invalidate(multicastGroupId string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, multicastGroupId)
+}
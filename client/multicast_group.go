@@ -3,21 +3,37 @@ package client
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/chirpstack/chirpstack/api/go/v4/api"
-	"github.com/chirpstack/chirpstack/api/go/v4/common"
 )
 
+// ListMulticastGroups returns up to limit multicast groups of the application
+// matching name, walking as many pages as needed. A limit of 0 returns all
+// matching multicast groups.
 func (c *chirpstack) ListMulticastGroups(ctx context.Context, applicationID, name string, limit uint32) ([]*api.MulticastGroupListItem, error) {
-	resp, err := c.multicastGroupServiceClient.List(ctx, &api.ListMulticastGroupsRequest{
-		ApplicationId: applicationID,
-		Search:        name,
-		Limit:         limit,
+	return collect(c.listMulticastGroups(ctx, applicationID, name, limit))
+}
+
+// IterateMulticastGroups returns an iterator over all multicast groups of the
+// application matching name.
+func (c *chirpstack) IterateMulticastGroups(ctx context.Context, applicationID, name string) iter.Seq2[*api.MulticastGroupListItem, error] {
+	return c.listMulticastGroups(ctx, applicationID, name, 0)
+}
+
+func (c *chirpstack) listMulticastGroups(ctx context.Context, applicationID, name string, limit uint32) iter.Seq2[*api.MulticastGroupListItem, error] {
+	return paginate(limit, func(offset, limit uint32) ([]*api.MulticastGroupListItem, uint32, error) {
+		resp, err := c.multicastGroupServiceClient.List(ctx, &api.ListMulticastGroupsRequest{
+			ApplicationId: applicationID,
+			Search:        name,
+			Limit:         limit,
+			Offset:        offset,
+		})
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to list multicast groups from chirpstack: %w", err)
+		}
+		return resp.Result, resp.TotalCount, nil
 	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to list multicast groups from chirpstack: %v", err)
-	}
-	return resp.Result, nil
 }
 
 func (c *chirpstack) GetMulticastGroup(ctx context.Context, id string) (*api.GetMulticastGroupResponse, error) {
@@ -27,57 +43,132 @@ func (c *chirpstack) GetMulticastGroup(ctx context.Context, id string) (*api.Get
 	return resp, err
 }
 
-func (c *chirpstack) CreateMulticastGroup(ctx context.Context, applicationID, name string, region common.Region, mcAddr, mcNwkSKey, mcAppSKey string, fCnt uint32, dr, frequency uint32) error {
-	device := api.MulticastGroup{
-		Name:                 name,
-		ApplicationId:        applicationID,
-		Region:               region,
-		McAddr:               mcAddr,
-		McNwkSKey:            mcNwkSKey,
-		McAppSKey:            mcAppSKey,
-		FCnt:                 fCnt,
-		GroupType:            api.MulticastGroupType_CLASS_C,
-		Dr:                   dr,
-		Frequency:            frequency,
-		ClassCSchedulingType: api.MulticastGroupSchedulingType_GPS_TIME,
+// CreateMulticastGroup creates the multicast group as given and returns its ID.
+func (c *chirpstack) CreateMulticastGroup(ctx context.Context, multicastGroup *api.MulticastGroup) (string, error) {
+	resp, err := c.multicastGroupServiceClient.Create(ctx, &api.CreateMulticastGroupRequest{
+		MulticastGroup: multicastGroup,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create multicast group in chirpstack; name: %s; err: %w;", multicastGroup.Name, err)
 	}
-	_, err := c.multicastGroupServiceClient.Create(ctx, &api.CreateMulticastGroupRequest{
-		MulticastGroup: &device,
+	return resp.Id, nil
+}
+
+func (c *chirpstack) UpdateMulticastGroup(ctx context.Context, multicastGroup *api.MulticastGroup) error {
+	_, err := c.multicastGroupServiceClient.Update(ctx, &api.UpdateMulticastGroupRequest{
+		MulticastGroup: multicastGroup,
 	})
 	if err != nil {
-		return fmt.Errorf("failed to create multicast group in chirpstack; device: %s; err: %+v;", device.String(), err)
+		return fmt.Errorf("failed to update multicast group in chirpstack; id: %s; err: %w;", multicastGroup.Id, err)
 	}
 	return nil
 }
 
 func (c *chirpstack) DeleteMulticastGroup(ctx context.Context, id string) error {
+	defer c.multicastGroupDevices.invalidate(id)
+	defer c.multicastGroupGateways.invalidate(id)
 	_, err := c.multicastGroupServiceClient.Delete(ctx, &api.DeleteMulticastGroupRequest{
 		Id: id,
 	})
 	if err != nil {
-		return fmt.Errorf("failed to delete multicast group in chirpstack; id: %s; err: %+v;", id, err)
+		return fmt.Errorf("failed to delete multicast group in chirpstack; id: %s; err: %w;", id, err)
 	}
 	return nil
 }
 
 func (c *chirpstack) AddGatewayToMulticastGroup(ctx context.Context, multicastGroupId, gatewayId string) error {
+	defer c.multicastGroupGateways.invalidate(multicastGroupId)
 	_, err := c.multicastGroupServiceClient.AddGateway(ctx, &api.AddGatewayToMulticastGroupRequest{
 		MulticastGroupId: multicastGroupId,
 		GatewayId:        gatewayId,
 	})
 	if err != nil {
-		return fmt.Errorf("failed to add gateway to multicast group in chirpstack; multicast group ID: %s; gatewayId: %s err: %+v;", multicastGroupId, gatewayId, err)
+		return fmt.Errorf("failed to add gateway to multicast group in chirpstack; multicast group ID: %s; gatewayId: %s err: %w;", multicastGroupId, gatewayId, err)
 	}
 	return nil
 }
 
 func (c *chirpstack) RemoveGatewayFromMulticastGroup(ctx context.Context, multicastGroupId, gatewayId string) error {
+	defer c.multicastGroupGateways.invalidate(multicastGroupId)
 	_, err := c.multicastGroupServiceClient.RemoveGateway(ctx, &api.RemoveGatewayFromMulticastGroupRequest{
 		MulticastGroupId: multicastGroupId,
 		GatewayId:        gatewayId,
 	})
 	if err != nil {
-		return fmt.Errorf("failed to remove gateway from multicast group in chirpstack; multicast group ID: %s; gatewayId: %s err: %+v;", multicastGroupId, gatewayId, err)
+		return fmt.Errorf("failed to remove gateway from multicast group in chirpstack; multicast group ID: %s; gatewayId: %s err: %w;", multicastGroupId, gatewayId, err)
+	}
+	return nil
+}
+
+func (c *chirpstack) AddDeviceToMulticastGroup(ctx context.Context, multicastGroupId, devEui string) error {
+	defer c.multicastGroupDevices.invalidate(multicastGroupId)
+	_, err := c.multicastGroupServiceClient.AddDevice(ctx, &api.AddDeviceToMulticastGroupRequest{
+		MulticastGroupId: multicastGroupId,
+		DevEui:           devEui,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add device to multicast group in chirpstack; multicast group ID: %s; devEui: %s err: %w;", multicastGroupId, devEui, err)
+	}
+	return nil
+}
+
+func (c *chirpstack) RemoveDeviceFromMulticastGroup(ctx context.Context, multicastGroupId, devEui string) error {
+	defer c.multicastGroupDevices.invalidate(multicastGroupId)
+	_, err := c.multicastGroupServiceClient.RemoveDevice(ctx, &api.RemoveDeviceFromMulticastGroupRequest{
+		MulticastGroupId: multicastGroupId,
+		DevEui:           devEui,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove device from multicast group in chirpstack; multicast group ID: %s; devEui: %s err: %w;", multicastGroupId, devEui, err)
 	}
 	return nil
 }
+
+// ListMulticastGroupDevices returns all devices that are a member of the multicast group.
+// The devices are cached until the members of the group are changed through
+// this client.
+func (c *chirpstack) ListMulticastGroupDevices(ctx context.Context, multicastGroupId string) ([]*api.DeviceListItem, error) {
+	return c.multicastGroupDevices.get(multicastGroupId, func() ([]*api.DeviceListItem, error) {
+		return c.listMulticastGroupDevices(ctx, multicastGroupId)
+	})
+}
+
+func (c *chirpstack) listMulticastGroupDevices(ctx context.Context, multicastGroupId string) ([]*api.DeviceListItem, error) {
+	multicastGroup, err := c.GetMulticastGroup(ctx, multicastGroupId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get multicast group from chirpstack; id: %s; err: %w;", multicastGroupId, err)
+	}
+
+	result, err := collect(c.listDevices(ctx, multicastGroup.MulticastGroup.ApplicationId, multicastGroupId, "", 0))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list devices of multicast group from chirpstack; id: %s; err: %w;", multicastGroupId, err)
+	}
+	return result, nil
+}
+
+// ListMulticastGroupGateways returns all gateways that are a member of the multicast group.
+// The gateways are cached until the members of the group are changed through
+// this client.
+func (c *chirpstack) ListMulticastGroupGateways(ctx context.Context, multicastGroupId string) ([]*api.GatewayListItem, error) {
+	return c.multicastGroupGateways.get(multicastGroupId, func() ([]*api.GatewayListItem, error) {
+		return c.listMulticastGroupGateways(ctx, multicastGroupId)
+	})
+}
+
+func (c *chirpstack) listMulticastGroupGateways(ctx context.Context, multicastGroupId string) ([]*api.GatewayListItem, error) {
+	multicastGroup, err := c.GetMulticastGroup(ctx, multicastGroupId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get multicast group from chirpstack; id: %s; err: %w;", multicastGroupId, err)
+	}
+	// Gateways are listed per tenant, so that tenant API keys are allowed to list them.
+	application, err := c.GetApplication(ctx, multicastGroup.MulticastGroup.ApplicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := collect(c.listGateways(ctx, application.TenantId, multicastGroupId, "", 0))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list gateways of multicast group from chirpstack; id: %s; err: %w;", multicastGroupId, err)
+	}
+	return result, nil
+}
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"iter"
+)
+
+// listPageSize is the number of items requested per List call when walking
+// all pages of a List endpoint.
+const listPageSize = 100
+
+// listPageFunc fetches a single page of a List endpoint. It returns the items
+// of the page together with the total number of items reported by ChirpStack.
+type listPageFunc[T any] func(offset, limit uint32) ([]T, uint32, error)
+
+// paginate returns an iterator over the items of a List endpoint. Pages are
+// requested with increasing offsets until TotalCount items have been returned,
+// or until limit items have been returned when limit is not 0.
+func paginate[T any](limit uint32, listPage listPageFunc[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var offset uint32
+		for {
+			size := uint32(listPageSize)
+			if limit != 0 && limit-offset < size {
+				size = limit - offset
+			}
+			items, total, err := listPage(offset, size)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			offset += uint32(len(items))
+			if len(items) == 0 || offset >= total || (limit != 0 && offset >= limit) {
+				return
+			}
+		}
+	}
+}
+
+// collect returns all items of the iterator, or the first error it yields.
+func collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var result []T
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+	return result, nil
+}
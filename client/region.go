@@ -0,0 +1,30 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ListRegions returns the regions enabled in the Chirpstack configuration.
+func (c *chirpstack) ListRegions(ctx context.Context) ([]*api.RegionListItem, error) {
+	resp, err := c.internalServiceClient.ListRegions(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list regions from chirpstack; err: %w;", err)
+	}
+	return resp.Regions, nil
+}
+
+// GetRegion returns the configuration of the region with the given region
+// configuration ID.
+func (c *chirpstack) GetRegion(ctx context.Context, id string) (*api.GetRegionResponse, error) {
+	resp, err := c.internalServiceClient.GetRegion(ctx, &api.GetRegionRequest{
+		Id: id,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get region from chirpstack; id: %s; err: %w;", id, err)
+	}
+	return resp, nil
+}
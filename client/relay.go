@@ -0,0 +1,49 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+)
+
+func (c *chirpstack) AddRelayDevice(ctx context.Context, relayDevEui, devEui string) error {
+	_, err := c.relayServiceClient.AddDevice(ctx, &api.AddRelayDeviceRequest{
+		RelayDevEui:  relayDevEui,
+		DeviceDevEui: devEui,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add device to relay in chirpstack; relay devEui: %s; devEui: %s err: %w;", relayDevEui, devEui, err)
+	}
+	return nil
+}
+
+func (c *chirpstack) RemoveRelayDevice(ctx context.Context, relayDevEui, devEui string) error {
+	_, err := c.relayServiceClient.RemoveDevice(ctx, &api.RemoveRelayDeviceRequest{
+		RelayDevEui:  relayDevEui,
+		DeviceDevEui: devEui,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove device from relay in chirpstack; relay devEui: %s; devEui: %s err: %w;", relayDevEui, devEui, err)
+	}
+	return nil
+}
+
+// ListRelayDevices returns all devices that are relayed by the relay.
+func (c *chirpstack) ListRelayDevices(ctx context.Context, relayDevEui string) ([]*api.RelayDeviceListItem, error) {
+	result, err := collect(paginate(0, func(offset, limit uint32) ([]*api.RelayDeviceListItem, uint32, error) {
+		resp, err := c.relayServiceClient.ListDevices(ctx, &api.ListRelayDevicesRequest{
+			RelayDevEui: relayDevEui,
+			Limit:       limit,
+			Offset:      offset,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Result, resp.TotalCount, nil
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list devices of relay from chirpstack; relay devEui: %s; err: %w;", relayDevEui, err)
+	}
+	return result, nil
+}
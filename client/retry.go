@@ -0,0 +1,144 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RetryPolicy configures how failed ChirpStack calls are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts per call, including the
+	// first one. A value of 1 or less disables retries.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry. The delay doubles
+	// with every further retry, up to MaxBackoff, and is jittered.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// CallTimeout is the deadline of a single attempt. 0 means no deadline.
+	CallTimeout time.Duration
+	// RetryableCodes are the gRPC codes for which a call is retried.
+	RetryableCodes []codes.Code
+}
+
+// DefaultRetryPolicy is used for settings that are not configured.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    5,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     10 * time.Second,
+	CallTimeout:    30 * time.Second,
+	RetryableCodes: []codes.Code{codes.Unavailable, codes.ResourceExhausted},
+}
+
+// codeNames maps the canonical names of gRPC codes, as used in the provider
+// configuration, to their codes.
+var codeNames = map[string]codes.Code{
+	"CANCELLED":           codes.Canceled,
+	"UNKNOWN":             codes.Unknown,
+	"INVALID_ARGUMENT":    codes.InvalidArgument,
+	"DEADLINE_EXCEEDED":   codes.DeadlineExceeded,
+	"NOT_FOUND":           codes.NotFound,
+	"ALREADY_EXISTS":      codes.AlreadyExists,
+	"PERMISSION_DENIED":   codes.PermissionDenied,
+	"RESOURCE_EXHAUSTED":  codes.ResourceExhausted,
+	"FAILED_PRECONDITION": codes.FailedPrecondition,
+	"ABORTED":             codes.Aborted,
+	"OUT_OF_RANGE":        codes.OutOfRange,
+	"UNIMPLEMENTED":       codes.Unimplemented,
+	"INTERNAL":            codes.Internal,
+	"UNAVAILABLE":         codes.Unavailable,
+	"DATA_LOSS":           codes.DataLoss,
+	"UNAUTHENTICATED":     codes.Unauthenticated,
+}
+
+// CodeNames returns the sorted canonical names of the gRPC codes accepted by
+// ParseCode.
+func CodeNames() []string {
+	names := make([]string, 0, len(codeNames))
+	for name := range codeNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ParseCode returns the gRPC code for its canonical name, e.g. UNAVAILABLE.
+func ParseCode(name string) (codes.Code, error) {
+	code, ok := codeNames[name]
+	if !ok {
+		return codes.Unknown, fmt.Errorf("unknown gRPC code %q", name)
+	}
+	return code, nil
+}
+
+// backoff returns the delay before the given retry (starting at 1), using
+// exponential backoff with equal jitter.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.InitialBackoff
+	for i := 1; i < retry && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	if delay <= 0 {
+		return 0
+	}
+	half := delay / 2
+	return half + rand.N(delay-half+1)
+}
+
+// unaryInterceptor returns a gRPC interceptor applying the retry policy to
+// every unary call. The deadline of a single attempt is applied by
+// callTimeoutInterceptor.
+func (p RetryPolicy) unaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		for attempt := 1; ; attempt++ {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+
+			code := status.Code(err)
+			if err == nil || attempt >= p.MaxAttempts || !slices.Contains(p.RetryableCodes, code) || ctx.Err() != nil {
+				return err
+			}
+
+			delay := p.backoff(attempt)
+			tflog.Warn(ctx, "Retrying Chirpstack call", map[string]interface{}{
+				"method":  method,
+				"attempt": attempt,
+				"code":    code.String(),
+				"error":   err.Error(),
+				"backoff": delay.String(),
+			})
+
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return err
+			case <-timer.C:
+			}
+		}
+	}
+}
+
+// callTimeoutInterceptor returns a gRPC interceptor applying CallTimeout to
+// every attempt of a unary call. It runs after the limiter, so that the time
+// an attempt is queued does not count against its deadline.
+func (p RetryPolicy) callTimeoutInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if p.CallTimeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, p.CallTimeout)
+			defer cancel()
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -3,20 +3,35 @@ package client
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/chirpstack/chirpstack/api/go/v4/api"
 )
 
+// ListTenants returns up to limit tenants matching name, walking as many pages
+// as needed. A limit of 0 returns all matching tenants.
 func (c *chirpstack) ListTenants(ctx context.Context, name string, limit uint32) ([]*api.TenantListItem, error) {
-	listTenantsRequest := api.ListTenantsRequest{
-		Limit:  limit,
-		Search: name,
-	}
-	listTenantsResponse, listErr := c.tenantServiceClient.List(ctx, &listTenantsRequest)
-	if listErr != nil {
-		return nil, fmt.Errorf("failed to list tenants; err: %s;", listErr)
-	}
-	return listTenantsResponse.Result, nil
+	return collect(c.listTenants(ctx, name, limit))
+}
+
+// IterateTenants returns an iterator over all tenants matching name.
+func (c *chirpstack) IterateTenants(ctx context.Context, name string) iter.Seq2[*api.TenantListItem, error] {
+	return c.listTenants(ctx, name, 0)
+}
+
+func (c *chirpstack) listTenants(ctx context.Context, name string, limit uint32) iter.Seq2[*api.TenantListItem, error] {
+	return paginate(limit, func(offset, limit uint32) ([]*api.TenantListItem, uint32, error) {
+		listTenantsRequest := api.ListTenantsRequest{
+			Limit:  limit,
+			Offset: offset,
+			Search: name,
+		}
+		listTenantsResponse, listErr := c.tenantServiceClient.List(ctx, &listTenantsRequest)
+		if listErr != nil {
+			return nil, 0, fmt.Errorf("failed to list tenants; err: %w;", listErr)
+		}
+		return listTenantsResponse.Result, listTenantsResponse.TotalCount, nil
+	})
 }
 
 func (c *chirpstack) GetTenant(ctx context.Context, id string) (*api.Tenant, error) {
@@ -25,7 +40,7 @@ func (c *chirpstack) GetTenant(ctx context.Context, id string) (*api.Tenant, err
 	}
 	resp, err := c.tenantServiceClient.Get(ctx, &req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get tenant %s; err: %s;", id, err)
+		return nil, fmt.Errorf("failed to get tenant %s; err: %w;", id, err)
 	}
 	return resp.Tenant, nil
 }
@@ -35,7 +50,7 @@ func (c *chirpstack) CreateTenant(ctx context.Context, tenant *api.Tenant) (stri
 		Tenant: tenant,
 	})
 	if err != nil {
-		return "", fmt.Errorf("failed to create tenant %+v; err: %s;", tenant, err)
+		return "", fmt.Errorf("failed to create tenant %+v; err: %w;", tenant, err)
 	}
 	return listTenantsResponse.Id, nil
 }
@@ -46,7 +61,7 @@ func (c *chirpstack) UpdateTenant(ctx context.Context, tenant *api.Tenant) error
 	}
 	_, err := c.tenantServiceClient.Update(ctx, &updateTenantsRequest)
 	if err != nil {
-		return fmt.Errorf("failed to update tenant %s; err: %s;", tenant.Id, err)
+		return fmt.Errorf("failed to update tenant %s; err: %w;", tenant.Id, err)
 	}
 	return nil
 }
@@ -57,7 +72,7 @@ func (c *chirpstack) DeleteTenant(ctx context.Context, id string) error {
 	}
 	_, err := c.tenantServiceClient.Delete(ctx, &deleteTenantsRequest)
 	if err != nil {
-		return fmt.Errorf("failed to delete tenant id %s; err: %s;", id, err)
+		return fmt.Errorf("failed to delete tenant id %s; err: %w;", id, err)
 	}
 	return nil
 }
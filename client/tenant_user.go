@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+)
+
+func (c *chirpstack) AddTenantUser(ctx context.Context, tenantUser *api.TenantUser) error {
+	_, err := c.tenantServiceClient.AddUser(ctx, &api.AddTenantUserRequest{
+		TenantUser: tenantUser,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add user %s to tenant %s; err: %w;", tenantUser.UserId, tenantUser.TenantId, err)
+	}
+	return nil
+}
+
+func (c *chirpstack) GetTenantUser(ctx context.Context, tenantID, userID string) (*api.TenantUser, error) {
+	resp, err := c.tenantServiceClient.GetUser(ctx, &api.GetTenantUserRequest{
+		TenantId: tenantID,
+		UserId:   userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user %s of tenant %s; err: %w;", userID, tenantID, err)
+	}
+	return resp.TenantUser, nil
+}
+
+func (c *chirpstack) UpdateTenantUser(ctx context.Context, tenantUser *api.TenantUser) error {
+	_, err := c.tenantServiceClient.UpdateUser(ctx, &api.UpdateTenantUserRequest{
+		TenantUser: tenantUser,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update user %s of tenant %s; err: %w;", tenantUser.UserId, tenantUser.TenantId, err)
+	}
+	return nil
+}
+
+func (c *chirpstack) DeleteTenantUser(ctx context.Context, tenantID, userID string) error {
+	_, err := c.tenantServiceClient.DeleteUser(ctx, &api.DeleteTenantUserRequest{
+		TenantId: tenantID,
+		UserId:   userID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove user %s from tenant %s; err: %w;", userID, tenantID, err)
+	}
+	return nil
+}
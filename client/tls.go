@@ -0,0 +1,83 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// TLSMode selects how the connection to ChirpStack is secured.
+type TLSMode string
+
+const (
+	// TLSModeVerify uses TLS and verifies the server certificate.
+	TLSModeVerify TLSMode = "verify"
+	// TLSModeInsecure uses TLS, but accepts any server certificate.
+	TLSModeInsecure TLSMode = "insecure"
+	// TLSModePlaintext does not use TLS at all.
+	TLSModePlaintext TLSMode = "plaintext"
+)
+
+// TLSConfig describes the transport security of the connection to ChirpStack.
+type TLSConfig struct {
+	// Mode defaults to TLSModeVerify when empty.
+	Mode TLSMode
+	// CACertFile and CACertPEM replace the system certificate pool used to
+	// verify the server certificate. At most one of them should be set.
+	CACertFile string
+	CACertPEM  string
+	// ServerName overrides the name used to verify the server certificate.
+	ServerName string
+	// ClientCert and ClientKey are the PEM encoded certificate and key used
+	// for mutual TLS.
+	ClientCert string
+	ClientKey  string
+}
+
+func (c TLSConfig) transportCredentials() (credentials.TransportCredentials, error) {
+	switch c.Mode {
+	case TLSModePlaintext:
+		return insecure.NewCredentials(), nil
+	case TLSModeVerify, TLSModeInsecure, "":
+	default:
+		return nil, fmt.Errorf("unknown TLS mode %q", c.Mode)
+	}
+
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: c.ServerName,
+		// Only skip verification when explicitly asked for.
+		InsecureSkipVerify: c.Mode == TLSModeInsecure,
+	}
+
+	caCert := []byte(c.CACertPEM)
+	if c.CACertFile != "" {
+		var err error
+		caCert, err = os.ReadFile(c.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA certificate; err: %w;", err)
+		}
+	}
+	if len(caCert) != 0 {
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(caCert) {
+			return nil, errors.New("no PEM encoded certificates found in CA certificate")
+		}
+		config.RootCAs = certPool
+	}
+
+	if c.ClientCert != "" || c.ClientKey != "" {
+		certificate, err := tls.X509KeyPair([]byte(c.ClientCert), []byte(c.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate; err: %w;", err)
+		}
+		config.Certificates = []tls.Certificate{certificate}
+	}
+
+	return credentials.NewTLS(config), nil
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+)
+
+func (c *chirpstack) CreateUser(ctx context.Context, user *api.User, password string) (string, error) {
+	resp, err := c.userServiceClient.Create(ctx, &api.CreateUserRequest{
+		User:     user,
+		Password: password,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create user %s; err: %w;", user.Email, err)
+	}
+	return resp.Id, nil
+}
+
+func (c *chirpstack) GetUser(ctx context.Context, id string) (*api.User, error) {
+	resp, err := c.userServiceClient.Get(ctx, &api.GetUserRequest{
+		Id: id,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user %s; err: %w;", id, err)
+	}
+	return resp.User, nil
+}
+
+func (c *chirpstack) UpdateUser(ctx context.Context, user *api.User) error {
+	_, err := c.userServiceClient.Update(ctx, &api.UpdateUserRequest{
+		User: user,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update user %s; err: %w;", user.Id, err)
+	}
+	return nil
+}
+
+func (c *chirpstack) UpdateUserPassword(ctx context.Context, id, password string) error {
+	_, err := c.userServiceClient.UpdatePassword(ctx, &api.UpdateUserPasswordRequest{
+		UserId:   id,
+		Password: password,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update password of user %s; err: %w;", id, err)
+	}
+	return nil
+}
+
+func (c *chirpstack) DeleteUser(ctx context.Context, id string) error {
+	_, err := c.userServiceClient.Delete(ctx, &api.DeleteUserRequest{
+		Id: id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete user %s; err: %w;", id, err)
+	}
+	return nil
+}
@@ -0,0 +1,4 @@
+data "chirpstack_application" "application" {
+  tenant_id = data.chirpstack_tenant.tenant.id
+  name      = "myapplication"
+}
@@ -0,0 +1,3 @@
+data "chirpstack_applications" "applications" {
+  tenant_id = data.chirpstack_tenant.tenant.id
+}
@@ -0,0 +1,10 @@
+# Look up a device by DevEUI.
+data "chirpstack_device" "by_dev_eui" {
+  dev_eui = "0102030405060708"
+}
+
+# Look up a device by name within an application.
+data "chirpstack_device" "by_name" {
+  application_id = data.chirpstack_application.application.id
+  name           = "mydevice"
+}
@@ -0,0 +1,4 @@
+data "chirpstack_device_profile" "device_profile" {
+  tenant_id = data.chirpstack_tenant.tenant.id
+  name      = "mydeviceprofile"
+}
@@ -0,0 +1,4 @@
+data "chirpstack_device_profiles" "device_profiles" {
+  tenant_id = data.chirpstack_tenant.tenant.id
+  search    = "sensor"
+}
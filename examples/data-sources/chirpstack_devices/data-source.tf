@@ -0,0 +1,14 @@
+data "chirpstack_devices" "devices" {
+  application_id = data.chirpstack_application.application.id
+  tags = {
+    site = "farm-1"
+  }
+}
+
+# Add all devices of the site to a multicast group.
+resource "chirpstack_multicast_group_device" "site" {
+  for_each = { for device in data.chirpstack_devices.devices.devices : device.dev_eui => device }
+
+  multicast_group_id = chirpstack_multicast_group.site.id
+  dev_eui            = each.key
+}
@@ -0,0 +1,4 @@
+data "chirpstack_gateway" "gateway" {
+  tenant_id = data.chirpstack_tenant.tenant.id
+  name      = "mygateway"
+}
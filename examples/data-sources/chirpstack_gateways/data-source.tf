@@ -0,0 +1,3 @@
+data "chirpstack_gateways" "gateways" {
+  tenant_id = data.chirpstack_tenant.tenant.id
+}
@@ -0,0 +1,4 @@
+data "chirpstack_multicast_group" "multicast_group" {
+  application_id = data.chirpstack_application.application.id
+  name           = "mymulticastgroup"
+}
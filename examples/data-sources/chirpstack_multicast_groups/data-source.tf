@@ -0,0 +1,3 @@
+data "chirpstack_multicast_groups" "multicast_groups" {
+  application_id = data.chirpstack_application.application.id
+}
@@ -0,0 +1,5 @@
+data "chirpstack_regions" "all" {}
+
+output "region_config_ids" {
+  value = [for region in data.chirpstack_regions.all.regions : region.id if region.region == "AU915"]
+}
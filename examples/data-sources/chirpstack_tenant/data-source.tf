@@ -0,0 +1,3 @@
+data "chirpstack_tenant" "tenant" {
+  name = "mytenant"
+}
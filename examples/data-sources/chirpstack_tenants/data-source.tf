@@ -0,0 +1 @@
+data "chirpstack_tenants" "all" {}
@@ -0,0 +1,7 @@
+locals {
+  decoded = provider::chirpstack::decode_uplink(file("${path.module}/codec.js"), 10, "08fc", {})
+}
+
+output "temperature" {
+  value = local.decoded.data.temperature
+}
@@ -0,0 +1,13 @@
+function decodeUplink(input) {
+  return {
+    data: {
+      temperature: ((input.bytes[0] << 8) | input.bytes[1]) / 100,
+    },
+  };
+}
+
+function encodeDownlink(input) {
+  return {
+    bytes: [input.data.interval & 0xff],
+  };
+}
@@ -0,0 +1,7 @@
+locals {
+  encoded = provider::chirpstack::encode_downlink(file("${path.module}/codec.js"), { interval = 600 })
+}
+
+output "downlink" {
+  value = local.encoded.bytes_hex
+}
@@ -1,3 +1,58 @@
-provider "scaffolding" {
-  # example configuration here
+provider "chirpstack" {
+  host = "chirpstack.example.com"
+  port = 443
+  key  = var.chirpstack_api_key
+
+  # Verify the server certificate against a private CA.
+  tls_mode     = "verify"
+  ca_cert_file = "/etc/ssl/private-ca.pem"
+
+  # Ride out ChirpStack restarts during large applies.
+  retry = {
+    max_attempts    = 8
+    initial_backoff = "1s"
+    max_backoff     = "30s"
+    call_timeout    = "1m"
+    retryable_codes = ["UNAVAILABLE", "RESOURCE_EXHAUSTED"]
+  }
+
+  # Keep large plans from overloading the ChirpStack server.
+  max_requests_per_second = 20
+  max_concurrent_requests = 4
+
+  # Added to every tenant, application, device profile, device and gateway.
+  default_tags = {
+    tags = {
+      managed_by = "terraform"
+    }
+  }
+}
+
+# A ChirpStack with a self-signed certificate. The server certificate is
+# verified by default, so it must be trusted with ca_cert_file or, as here,
+# not verified at all.
+provider "chirpstack" {
+  alias    = "self_signed"
+  host     = "chirpstack.internal"
+  port     = 443
+  key      = var.chirpstack_api_key
+  tls_mode = "insecure"
+}
+
+# A local or in-cluster ChirpStack without TLS.
+provider "chirpstack" {
+  alias    = "local"
+  host     = "localhost"
+  port     = 8080
+  key      = var.chirpstack_api_key
+  tls_mode = "plaintext"
+}
+
+# Log in as a user to bootstrap a fresh installation that has no api keys yet.
+provider "chirpstack" {
+  alias    = "bootstrap"
+  host     = "chirpstack.example.com"
+  port     = 443
+  email    = "admin"
+  password = var.chirpstack_admin_password
 }
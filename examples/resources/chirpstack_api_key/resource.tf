@@ -0,0 +1,14 @@
+resource "chirpstack_tenant" "tenant" {
+  name = "mytenant"
+}
+
+# Credentials of an integration that only needs access to its own tenant.
+resource "chirpstack_api_key" "integration" {
+  name      = "my-integration"
+  tenant_id = chirpstack_tenant.tenant.id
+}
+
+resource "chirpstack_api_key" "admin" {
+  name     = "terraform"
+  is_admin = true
+}
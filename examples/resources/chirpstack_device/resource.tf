@@ -0,0 +1,12 @@
+resource "chirpstack_device" "sensor" {
+  dev_eui           = "0102030405060708"
+  name              = "sensor-01"
+  description       = "Soil moisture sensor"
+  application_id    = chirpstack_application.application.id
+  device_profile_id = chirpstack_device_profile.mydeviceprofile.id
+  join_eui          = "0000000000000000"
+
+  tags = {
+    site = "paddock-1"
+  }
+}
@@ -0,0 +1,17 @@
+# LoRaWAN 1.0.x ABP device
+resource "chirpstack_device_activation" "sensor" {
+  dev_eui       = chirpstack_device.sensor.dev_eui
+  dev_addr      = "01020304"
+  app_s_key     = var.sensor_app_s_key
+  nwk_s_enc_key = var.sensor_nwk_s_key
+}
+
+# LoRaWAN 1.1.x ABP device
+resource "chirpstack_device_activation" "tracker" {
+  dev_eui         = chirpstack_device.tracker.dev_eui
+  dev_addr        = "01020305"
+  app_s_key       = var.tracker_app_s_key
+  nwk_s_enc_key   = var.tracker_nwk_s_enc_key
+  s_nwk_s_int_key = var.tracker_s_nwk_s_int_key
+  f_nwk_s_int_key = var.tracker_f_nwk_s_int_key
+}
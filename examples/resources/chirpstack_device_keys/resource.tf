@@ -0,0 +1,5 @@
+resource "chirpstack_device_keys" "sensor" {
+  dev_eui = chirpstack_device.sensor.dev_eui
+  nwk_key = var.sensor_nwk_key
+  app_key = var.sensor_app_key
+}
@@ -13,4 +13,19 @@ resource "chirpstack_device_profile" "mydeviceprofile" {
   device_supports_otaa            = true
   expected_uplink_interval        = 3600
   flush_queue_on_activate         = true
+  payload_codec_runtime           = "JS"
+  payload_codec_script            = file("${path.module}/codec.js")
+
+  auto_detect_measurements          = true
+  ignore_auto_detected_measurements = true
+  measurements = {
+    temperature = {
+      name = "Temperature"
+      kind = "GAUGE"
+    }
+  }
+
+  tags = {
+    vendor = "acme"
+  }
 }
@@ -0,0 +1,17 @@
+resource "chirpstack_gateway" "gateway" {
+  gateway_id     = "0102030405060708"
+  tenant_id      = chirpstack_tenant.tenant.id
+  name           = "farm-gateway-01"
+  description    = "Gateway on the milking shed"
+  stats_interval = 30
+
+  location = {
+    latitude  = -37.7870
+    longitude = 175.2793
+    altitude  = 40
+  }
+
+  tags = {
+    site = "farm-1"
+  }
+}
@@ -0,0 +1,13 @@
+resource "chirpstack_mqtt_integration_certificate" "consumer" {
+  application_id = chirpstack_application.app.id
+  rotate_before  = "720h"
+}
+
+output "mqtt_tls_cert" {
+  value = chirpstack_mqtt_integration_certificate.consumer.tls_cert
+}
+
+output "mqtt_tls_key" {
+  value     = chirpstack_mqtt_integration_certificate.consumer.tls_key
+  sensitive = true
+}
@@ -0,0 +1,25 @@
+resource "chirpstack_multicast_group" "class_c" {
+  application_id          = chirpstack_application.application.id
+  name                    = "fuota-class-c"
+  region                  = "AU915"
+  group_type              = "CLASS_C"
+  class_c_scheduling_type = "GPS_TIME"
+  mc_addr                 = "01020304"
+  mc_nwk_s_key            = var.mc_nwk_s_key
+  mc_app_s_key            = var.mc_app_s_key
+  dr                      = 8
+  frequency               = 923300000
+}
+
+resource "chirpstack_multicast_group" "class_b" {
+  application_id         = chirpstack_application.application.id
+  name                   = "fuota-class-b"
+  region                 = "AU915"
+  group_type             = "CLASS_B"
+  class_b_ping_slot_nb_k = 3
+  mc_addr                = "01020305"
+  mc_nwk_s_key           = var.mc_nwk_s_key
+  mc_app_s_key           = var.mc_app_s_key
+  dr                     = 8
+  frequency              = 923300000
+}
@@ -0,0 +1,4 @@
+resource "chirpstack_multicast_group_device" "sensor" {
+  multicast_group_id = chirpstack_multicast_group.class_c.id
+  dev_eui            = chirpstack_device.sensor.dev_eui
+}
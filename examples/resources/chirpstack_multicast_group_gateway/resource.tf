@@ -0,0 +1,4 @@
+resource "chirpstack_multicast_group_gateway" "gateway" {
+  multicast_group_id = chirpstack_multicast_group.class_c.id
+  gateway_id         = chirpstack_gateway.gateway.gateway_id
+}
@@ -0,0 +1,5 @@
+resource "chirpstack_multicast_group_members" "class_c" {
+  multicast_group_id = chirpstack_multicast_group.class_c.id
+  dev_euis           = [for device in chirpstack_device.fleet : device.dev_eui]
+  gateway_ids        = [chirpstack_gateway.gateway.gateway_id]
+}
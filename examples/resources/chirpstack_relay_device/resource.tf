@@ -0,0 +1,19 @@
+resource "chirpstack_device_profile" "relay" {
+  tenant_id                  = chirpstack_tenant.tenant.id
+  name                       = "relay"
+  mac_version                = "LORAWAN_1_0_4"
+  region                     = "AU915"
+  region_parameters_revision = "RP002_1_0_3"
+  device_supports_otaa       = true
+
+  relay = {
+    is_relay        = true
+    enabled         = true
+    cad_periodicity = "MS_500"
+  }
+}
+
+resource "chirpstack_relay_device" "sensor" {
+  relay_dev_eui = chirpstack_device.relay.dev_eui
+  dev_eui       = chirpstack_device.sensor.dev_eui
+}
@@ -1,4 +1,8 @@
 resource "chirpstack_tenant" "tenant" {
   name        = "mytenant"
   description = "My Tenant"
+
+  tags = {
+    billing = "customer-a"
+  }
 }
@@ -0,0 +1,6 @@
+resource "chirpstack_tenant_user" "operator" {
+  tenant_id        = chirpstack_tenant.tenant.id
+  user_id          = chirpstack_user.operator.id
+  is_device_admin  = true
+  is_gateway_admin = true
+}
@@ -0,0 +1,6 @@
+resource "chirpstack_user" "operator" {
+  email            = "operator@example.com"
+  note             = "Customer operations"
+  password         = var.operator_password
+  password_version = 1 # Increment to set a new password.
+}
@@ -0,0 +1,245 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+	"github.com/halter-corp/terraform-provider-chirpstack/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ApiKeyResource{}
+var _ resource.ResourceWithValidateConfig = &ApiKeyResource{}
+
+func NewApiKeyResource() resource.Resource {
+	return &ApiKeyResource{}
+}
+
+// ApiKeyResource defines the resource implementation.
+type ApiKeyResource struct {
+	chirpstack client.Chirpstack
+}
+
+// ApiKeyResourceModel describes the resource data model.
+type ApiKeyResourceModel struct {
+	Id       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	IsAdmin  types.Bool   `tfsdk:"is_admin"`
+	TenantId types.String `tfsdk:"tenant_id"`
+	Token    types.String `tfsdk:"token"`
+}
+
+func (r *ApiKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (r *ApiKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Api key resource. Creates either an admin api key or an api key of a tenant. Api keys can not be changed, so every change replaces the key. The token is only returned on creation, so api keys can not be imported.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Api key identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Api key name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_admin": schema.BoolAttribute{
+				MarkdownDescription: "Create an admin api key. Conflicts with `tenant_id`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "Tenant ID of a tenant api key. Required unless `is_admin` is set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "Api token, to be used as the `key` of the provider or by other clients of the Chirpstack API.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ApiKeyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ApiKeyResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.IsAdmin.IsUnknown() || data.TenantId.IsUnknown() {
+		return
+	}
+
+	isAdmin := data.IsAdmin.ValueBool()
+	hasTenant := !data.TenantId.IsNull()
+	if isAdmin && hasTenant {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tenant_id"),
+			"Invalid Attribute Combination",
+			"An admin api key can not belong to a tenant. Either set is_admin or tenant_id.",
+		)
+	}
+	if !isAdmin && !hasTenant {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tenant_id"),
+			"Missing Attribute Configuration",
+			"Either is_admin must be true, or tenant_id must be set.",
+		)
+	}
+}
+
+func (r *ApiKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	chirpstack, ok := req.ProviderData.(client.Chirpstack)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Chirpstack, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.chirpstack = chirpstack
+}
+
+func apiKeyFromData(data *ApiKeyResourceModel) *api.ApiKey {
+	return &api.ApiKey{
+		Id:       data.Id.ValueString(),
+		Name:     data.Name.ValueString(),
+		IsAdmin:  data.IsAdmin.ValueBool(),
+		TenantId: data.TenantId.ValueString(),
+	}
+}
+
+func apiKeyToData(apiKey *api.ApiKey, data *ApiKeyResourceModel) {
+	data.Name = types.StringValue(apiKey.Name)
+	data.IsAdmin = types.BoolValue(apiKey.IsAdmin)
+	if apiKey.TenantId != "" {
+		data.TenantId = types.StringValue(apiKey.TenantId)
+	}
+}
+
+func (r *ApiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ApiKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKey := apiKeyFromData(&data)
+	id, token, err := r.chirpstack.CreateApiKey(ctx, apiKey)
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to create api key, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(id)
+	data.Token = types.StringValue(token)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ApiKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKey, err := r.chirpstack.GetApiKey(ctx, data.Id.ValueString(), data.IsAdmin.ValueBool(), data.TenantId.ValueString())
+	// The api key has been revoked outside of Terraform.
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read api key, got error: %s", err))
+		return
+	}
+
+	apiKeyToData(apiKey, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All attributes require replacement, so there is nothing to update.
+	var data ApiKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ApiKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.chirpstack.DeleteApiKey(ctx, data.Id.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to delete api key, got error: %s", err))
+		return
+	}
+}
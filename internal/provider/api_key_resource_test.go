@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApiKeyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccApiKeyResourceConfig("test_key"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("chirpstack_api_key.admin", "id"),
+					resource.TestCheckResourceAttrSet("chirpstack_api_key.admin", "token"),
+					resource.TestCheckResourceAttr("chirpstack_api_key.admin", "is_admin", "true"),
+					resource.TestCheckResourceAttrSet("chirpstack_api_key.tenant", "token"),
+					resource.TestCheckResourceAttr("chirpstack_api_key.tenant", "name", "test_key"),
+					resource.TestCheckResourceAttr("chirpstack_api_key.tenant", "is_admin", "false"),
+				),
+			},
+			// Replace testing
+			{
+				Config: testAccApiKeyResourceConfig("test_key_renamed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("chirpstack_api_key.tenant", "name", "test_key_renamed"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccApiKeyResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "chirpstack_tenant" "test" {
+  name = "test_tenant"
+}
+resource "chirpstack_api_key" "admin" {
+  name     = "test_admin_key"
+  is_admin = true
+}
+resource "chirpstack_api_key" "tenant" {
+  name      = %[1]q
+  tenant_id = chirpstack_tenant.test.id
+}
+`, name)
+}
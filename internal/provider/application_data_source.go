@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/halter-corp/terraform-provider-chirpstack/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ApplicationDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &ApplicationDataSource{}
+
+func NewApplicationDataSource() datasource.DataSource {
+	return &ApplicationDataSource{}
+}
+
+// ApplicationDataSource defines the data source implementation.
+type ApplicationDataSource struct {
+	chirpstack client.Chirpstack
+}
+
+func (d *ApplicationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application"
+}
+
+func (d *ApplicationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Application data source. Looks up an application by ID, or by exact name within a tenant.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Application identifier",
+				Optional:            true,
+				Computed:            true,
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "Tenant ID. Required when looking up the application by name.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Application name",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("tenant_id")),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Application description",
+				Computed:            true,
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags (user defined).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ApplicationDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
+func (d *ApplicationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	chirpstack, ok := req.ProviderData.(client.Chirpstack)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Chirpstack, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.chirpstack = chirpstack
+}
+
+func (d *ApplicationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ApplicationModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.Id.ValueString()
+	if data.Id.IsNull() {
+		name := data.Name.ValueString()
+		var matches []string
+		for application, err := range d.chirpstack.IterateApplications(ctx, data.TenantId.ValueString(), name) {
+			if err != nil {
+				resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to list applications, got error: %s", err))
+				return
+			}
+			if application.Name == name {
+				matches = append(matches, application.Id)
+			}
+		}
+		if len(matches) != 1 {
+			resp.Diagnostics.AddError("Application Not Found", fmt.Sprintf("Expected exactly one application with name %q, found %d", name, len(matches)))
+			return
+		}
+		id = matches[0]
+	}
+
+	application, err := d.chirpstack.GetApplication(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read application, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(application.Id)
+	applicationAttributesToData(application, &data)
+	data.Tags = stringMapToData(application.Tags, data.Tags)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApplicationDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccApplicationDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.chirpstack_application.by_id", "id", "chirpstack_application.test", "id"),
+					resource.TestCheckResourceAttrPair("data.chirpstack_application.by_name", "id", "chirpstack_application.test", "id"),
+					resource.TestCheckResourceAttr("data.chirpstack_application.by_id", "name", "datasource-app"),
+					resource.TestCheckResourceAttr("data.chirpstack_application.by_name", "name", "datasource-app"),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationDataSourceConfig() string {
+	return testAccApplicationResourceConfig("datasource-app") + `
+data "chirpstack_application" "by_id" {
+  id = chirpstack_application.test.id
+}
+data "chirpstack_application" "by_name" {
+  name      = chirpstack_application.test.name
+  tenant_id = chirpstack_tenant.test.id
+}
+`
+}
@@ -19,6 +19,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ApplicationResource{}
+var _ resource.ResourceWithModifyPlan = &ApplicationResource{}
 var _ resource.ResourceWithImportState = &ApplicationResource{}
 
 func NewApplicationResource() resource.Resource {
@@ -27,15 +28,24 @@ func NewApplicationResource() resource.Resource {
 
 // ApplicationResource defines the resource implementation.
 type ApplicationResource struct {
-	chirpstack client.Chirpstack
+	chirpstack  client.Chirpstack
+	defaultTags defaultTags
 }
 
-// ApplicationResourceModel describes the resource data model.
-type ApplicationResourceModel struct {
+// ApplicationModel describes the attributes shared by the application resource
+// and data source.
+type ApplicationModel struct {
 	Id          types.String `tfsdk:"id"`
 	TenantId    types.String `tfsdk:"tenant_id"`
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
+	Tags        types.Map    `tfsdk:"tags"`
+}
+
+// ApplicationResourceModel describes the resource data model.
+type ApplicationResourceModel struct {
+	ApplicationModel
+	TagsAll types.Map `tfsdk:"tags_all"`
 }
 
 func (r *ApplicationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -67,6 +77,16 @@ func (r *ApplicationResource) Schema(ctx context.Context, req resource.SchemaReq
 				MarkdownDescription: "Application description",
 				Optional:            true,
 			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags (user defined). These tags are exposed in the event payloads or to integration. Tags are intended for aggregation and filtering.",
+				Optional:            true,
+			},
+			"tags_all": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "All tags of the resource, including those inherited from the provider `default_tags`.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -89,6 +109,36 @@ func (r *ApplicationResource) Configure(ctx context.Context, req resource.Config
 	}
 
 	r.chirpstack = chirpstack
+	r.defaultTags = defaultTagsFromProviderData(req.ProviderData)
+}
+
+func (r *ApplicationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.modifyPlan(ctx, req, resp)
+}
+
+func applicationFromData(data *ApplicationResourceModel) *api.Application {
+	return &api.Application{
+		Id:          data.Id.ValueString(),
+		TenantId:    data.TenantId.ValueString(),
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		Tags:        stringMapFromData(data.TagsAll),
+	}
+}
+
+// applicationAttributesToData sets the attributes shared by the application
+// resource and data source, except for the tags.
+func applicationAttributesToData(application *api.Application, data *ApplicationModel) {
+	data.TenantId = types.StringValue(application.TenantId)
+	data.Name = types.StringValue(application.Name)
+	if application.Description != "" {
+		data.Description = types.StringValue(application.Description)
+	}
+}
+
+func applicationToData(application *api.Application, data *ApplicationResourceModel, defaults defaultTags) {
+	applicationAttributesToData(application, &data.ApplicationModel)
+	defaults.tagsToData(application.Tags, &data.Tags, &data.TagsAll)
 }
 
 func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -108,7 +158,8 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create application, got error: %s", err))
 	//     return
 	// }
-	id, err := r.chirpstack.CreateApplication(ctx, data.TenantId.ValueString(), data.Name.ValueString(), data.Description.ValueString())
+	application := applicationFromData(&data)
+	id, err := r.chirpstack.CreateApplication(ctx, application)
 	if err != nil {
 		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to create application, got error: %s", err))
 		return
@@ -117,6 +168,7 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 	// For the purposes of this application code, hardcoding a response value to
 	// save into the Terraform state.
 	data.Id = types.StringValue(id)
+	applicationToData(application, &data, r.defaultTags)
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
@@ -144,16 +196,17 @@ func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest
 	//     return
 	// }
 	application, err := r.chirpstack.GetApplication(ctx, data.Id.ValueString())
+	// The application has been deleted outside of Terraform.
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read application, got error: %s", err))
 		return
 	}
 
-	data.TenantId = types.StringValue(application.TenantId)
-	data.Name = types.StringValue(application.Name)
-	if application.Description != "" {
-		data.Description = types.StringValue(application.Description)
-	}
+	applicationToData(application, &data, r.defaultTags)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -176,16 +229,13 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update application, got error: %s", err))
 	//     return
 	// }
-	err := r.chirpstack.UpdateApplication(ctx, &api.Application{
-		TenantId:    data.TenantId.ValueString(),
-		Id:          data.Id.ValueString(),
-		Name:        data.Name.ValueString(),
-		Description: data.Description.ValueString(),
-	})
+	application := applicationFromData(&data)
+	err := r.chirpstack.UpdateApplication(ctx, application)
 	if err != nil {
 		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to update application, got error: %s", err))
 		return
 	}
+	applicationToData(application, &data, r.defaultTags)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -21,6 +21,7 @@ func TestAccApplicationResource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("chirpstack_application.test", "id"),
 					resource.TestCheckResourceAttr("chirpstack_application.test", "name", "application-one"),
+					resource.TestCheckResourceAttr("chirpstack_application.test", "tags.routing", "default"),
 				),
 			},
 			// ImportState testing
@@ -49,6 +50,9 @@ resource "chirpstack_tenant" "test" {
 resource "chirpstack_application" "test" {
   tenant_id = chirpstack_tenant.test.id
   name      = %[1]q
+  tags = {
+    routing = "default"
+  }
 }
 `, applicationName)
 }
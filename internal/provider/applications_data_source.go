@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/halter-corp/terraform-provider-chirpstack/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ApplicationsDataSource{}
+
+func NewApplicationsDataSource() datasource.DataSource {
+	return &ApplicationsDataSource{}
+}
+
+// ApplicationsDataSource defines the data source implementation.
+type ApplicationsDataSource struct {
+	chirpstack client.Chirpstack
+}
+
+// ApplicationsDataSourceModel describes the data source data model.
+type ApplicationsDataSourceModel struct {
+	TenantId     types.String                      `tfsdk:"tenant_id"`
+	Search       types.String                      `tfsdk:"search"`
+	Tags         types.Map                         `tfsdk:"tags"`
+	Applications []ApplicationsDataSourceItemModel `tfsdk:"applications"`
+}
+
+// ApplicationsDataSourceItemModel describes a single application of the data source.
+type ApplicationsDataSourceItemModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (d *ApplicationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_applications"
+}
+
+func (d *ApplicationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Applications data source. Lists all applications of a tenant.",
+
+		Attributes: map[string]schema.Attribute{
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "Tenant ID",
+				Required:            true,
+			},
+			"search": schema.StringAttribute{
+				MarkdownDescription: "Only return applications matching this search string.",
+				Optional:            true,
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only return applications that have all of these tags. Filtering on tags requires reading every application returned by the search.",
+				Optional:            true,
+			},
+			"applications": schema.ListNestedAttribute{
+				MarkdownDescription: "Applications",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Application identifier",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Application name",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Application description",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ApplicationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	chirpstack, ok := req.ProviderData.(client.Chirpstack)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Chirpstack, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.chirpstack = chirpstack
+}
+
+func (d *ApplicationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ApplicationsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags := stringMapFromData(data.Tags)
+	data.Applications = []ApplicationsDataSourceItemModel{}
+	for application, err := range d.chirpstack.IterateApplications(ctx, data.TenantId.ValueString(), data.Search.ValueString()) {
+		if err != nil {
+			resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to list applications, got error: %s", err))
+			return
+		}
+		// Tags are not part of the list response, so they can only be
+		// filtered on by reading the application.
+		if len(tags) != 0 {
+			fullApplication, err := d.chirpstack.GetApplication(ctx, application.Id)
+			if err != nil {
+				resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read application, got error: %s", err))
+				return
+			}
+			if !matchesTags(fullApplication.Tags, tags) {
+				continue
+			}
+		}
+		data.Applications = append(data.Applications, ApplicationsDataSourceItemModel{
+			Id:          types.StringValue(application.Id),
+			Name:        types.StringValue(application.Name),
+			Description: types.StringValue(application.Description),
+		})
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source", map[string]interface{}{"count": len(data.Applications)})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
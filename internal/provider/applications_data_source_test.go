@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApplicationsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccApplicationsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.chirpstack_applications.test", "applications.#", "1"),
+					resource.TestCheckResourceAttrPair("data.chirpstack_applications.test", "applications.0.id", "chirpstack_application.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationsDataSourceConfig() string {
+	return testAccApplicationResourceConfig("datasource-applications") + `
+data "chirpstack_applications" "test" {
+  tenant_id = chirpstack_application.test.tenant_id
+}
+`
+}
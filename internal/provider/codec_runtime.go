@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dop251/goja"
+)
+
+// codecTimeout limits the execution time of a payload codec function,
+// including the evaluation of the script itself.
+const codecTimeout = time.Second
+
+// codecRecvTime is passed to decodeUplink as the receive time of the uplink.
+// A fixed time keeps the provider functions deterministic.
+var codecRecvTime = time.Unix(0, 0).UTC()
+
+// runCodec evaluates a Chirpstack JavaScript payload codec and calls one of
+// its functions with the given input, returning the exported result object.
+//
+// The script runs in an embedded JavaScript engine without access to the file
+// system, the network or the provider process, and is interrupted when it
+// exceeds codecTimeout or ctx is cancelled.
+func runCodec(ctx context.Context, script, function string, input map[string]any) (map[string]any, error) {
+	vm := goja.New()
+
+	timer := time.AfterFunc(codecTimeout, func() {
+		vm.Interrupt(fmt.Errorf("payload codec did not finish within %s", codecTimeout))
+	})
+	defer timer.Stop()
+	stop := context.AfterFunc(ctx, func() {
+		vm.Interrupt(ctx.Err())
+	})
+	defer stop()
+
+	if _, err := vm.RunString(script); err != nil {
+		return nil, codecError(err)
+	}
+	fn, ok := goja.AssertFunction(vm.Get(function))
+	if !ok {
+		return nil, fmt.Errorf("payload codec does not define a %s function", function)
+	}
+
+	jsInput, err := toJS(vm, input)
+	if err != nil {
+		return nil, err
+	}
+	result, err := fn(goja.Undefined(), jsInput)
+	if err != nil {
+		return nil, codecError(err)
+	}
+
+	exported, ok := result.Export().(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must return an object, got: %s", function, result)
+	}
+	return exported, nil
+}
+
+// codecError unwraps the reason of an interrupted script.
+func codecError(err error) error {
+	var interrupted *goja.InterruptedError
+	if errors.As(err, &interrupted) {
+		if reason, ok := interrupted.Value().(error); ok {
+			return reason
+		}
+	}
+	return err
+}
+
+// toJS converts a Go value into a plain JavaScript value, so that scripts see
+// real arrays, objects and dates rather than wrapped Go values.
+func toJS(vm *goja.Runtime, v any) (goja.Value, error) {
+	switch v := v.(type) {
+	case map[string]any:
+		object := vm.NewObject()
+		for key, value := range v {
+			jsValue, err := toJS(vm, value)
+			if err != nil {
+				return nil, err
+			}
+			if err := object.Set(key, jsValue); err != nil {
+				return nil, err
+			}
+		}
+		return object, nil
+	case map[string]string:
+		object := vm.NewObject()
+		for key, value := range v {
+			if err := object.Set(key, value); err != nil {
+				return nil, err
+			}
+		}
+		return object, nil
+	case []any:
+		items := make([]any, 0, len(v))
+		for _, value := range v {
+			jsValue, err := toJS(vm, value)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, jsValue)
+		}
+		return vm.NewArray(items...), nil
+	case []byte:
+		items := make([]any, 0, len(v))
+		for _, b := range v {
+			items = append(items, int64(b))
+		}
+		return vm.NewArray(items...), nil
+	case time.Time:
+		return vm.New(vm.Get("Date"), vm.ToValue(v.UnixMilli()))
+	default:
+		return vm.ToValue(v), nil
+	}
+}
+
+// codecMessages returns the warnings or errors reported by a codec function.
+func codecMessages(result map[string]any, key string) []string {
+	values, _ := result[key].([]any)
+	messages := []string{}
+	for _, value := range values {
+		messages = append(messages, fmt.Sprint(value))
+	}
+	return messages
+}
@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = DecodeUplinkFunction{}
+)
+
+func NewDecodeUplinkFunction() function.Function {
+	return DecodeUplinkFunction{}
+}
+
+// DecodeUplinkFunction runs the decodeUplink function of a payload codec.
+type DecodeUplinkFunction struct{}
+
+func (r DecodeUplinkFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "decode_uplink"
+}
+
+func (r DecodeUplinkFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Decode an uplink with a JavaScript payload codec",
+		MarkdownDescription: "Runs the `decodeUplink` function of a Chirpstack JavaScript payload codec, as used by `payload_codec_script` of `chirpstack_device_profile`, " +
+			"so that codecs can be tested before they are applied. " +
+			"The codec runs in an embedded JavaScript engine without access to the file system or network and must finish within " + codecTimeout.String() + ". " +
+			"`recvTime` is always the Unix epoch, to keep the function deterministic.\n\n" +
+			"Returns an object with the `data`, `warnings` and `errors` returned by the codec. JavaScript arrays are returned as tuples and `null` as a null string.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "script",
+				MarkdownDescription: "JavaScript payload codec",
+			},
+			function.Int64Parameter{
+				Name:                "fport",
+				MarkdownDescription: "FPort of the uplink",
+			},
+			function.StringParameter{
+				Name:                "bytes_hex",
+				MarkdownDescription: "Hex encoded payload of the uplink",
+			},
+			function.MapParameter{
+				Name:                "variables",
+				MarkdownDescription: "Device variables, passed as `input.variables`",
+				ElementType:         types.StringType,
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+func (r DecodeUplinkFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var script, bytesHex string
+	var fPort int64
+	var variables types.Map
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &script, &fPort, &bytesHex, &variables))
+
+	if resp.Error != nil {
+		return
+	}
+
+	payload, err := hex.DecodeString(bytesHex)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("Invalid hex encoded payload: %s", err))
+		return
+	}
+	variablesMap := stringMapFromData(variables)
+	if variablesMap == nil {
+		variablesMap = map[string]string{}
+	}
+
+	result, err := runCodec(ctx, script, "decodeUplink", map[string]any{
+		"bytes":     payload,
+		"fPort":     fPort,
+		"recvTime":  codecRecvTime,
+		"variables": variablesMap,
+	})
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Unable to decode uplink: %s", err))
+		return
+	}
+
+	data, err := goToValue(result["data"])
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Unable to convert decoded data: %s", err))
+		return
+	}
+	output, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"data":     data.Type(ctx),
+			"warnings": types.ListType{ElemType: types.StringType},
+			"errors":   types.ListType{ElemType: types.StringType},
+		},
+		map[string]attr.Value{
+			"data":     data,
+			"warnings": stringListValue(codecMessages(result, "warnings")),
+			"errors":   stringListValue(codecMessages(result, "errors")),
+		},
+	)
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, types.DynamicValue(output)))
+}
+
+// stringListValue converts a Go slice into a Terraform list of strings.
+func stringListValue(s []string) types.List {
+	elements := []attr.Value{}
+	for _, v := range s {
+		elements = append(elements, types.StringValue(v))
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
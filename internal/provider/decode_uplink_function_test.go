@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestDecodeUplinkFunction_Known(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					decoded = provider::chirpstack::decode_uplink(<<-EOT
+						function decodeUplink(input) {
+							return {
+								data: {
+									temperature: ((input.bytes[0] << 8) | input.bytes[1]) / 100,
+									port: input.fPort,
+									site: input.variables.site,
+								},
+								warnings: input.bytes.length > 2 ? ["trailing bytes"] : [],
+							};
+						}
+					EOT
+					, 10, "08fc00", { site = "north" })
+				}
+
+				output "temperature" {
+					value = local.decoded.data.temperature
+				}
+				output "port" {
+					value = local.decoded.data.port
+				}
+				output "site" {
+					value = local.decoded.data.site
+				}
+				output "warnings" {
+					value = length(local.decoded.warnings)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("temperature", "23"),
+					resource.TestCheckOutput("port", "10"),
+					resource.TestCheckOutput("site", "north"),
+					resource.TestCheckOutput("warnings", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestDecodeUplinkFunction_InvalidHex(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::chirpstack::decode_uplink("function decodeUplink(input) { return {}; }", 1, "zz", null)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid hex encoded payload`),
+			},
+		},
+	})
+}
+
+func TestDecodeUplinkFunction_Timeout(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::chirpstack::decode_uplink("function decodeUplink(input) { while (true) {} }", 1, "00", null)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`did not finish within`),
+			},
+		},
+	})
+}
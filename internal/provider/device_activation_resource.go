@@ -0,0 +1,301 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+	"github.com/halter-corp/terraform-provider-chirpstack/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DeviceActivationResource{}
+var _ resource.ResourceWithImportState = &DeviceActivationResource{}
+
+func NewDeviceActivationResource() resource.Resource {
+	return &DeviceActivationResource{}
+}
+
+// DeviceActivationResource defines the resource implementation.
+type DeviceActivationResource struct {
+	chirpstack client.Chirpstack
+}
+
+// DeviceActivationResourceModel describes the resource data model.
+type DeviceActivationResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	DevEui      types.String `tfsdk:"dev_eui"`
+	DevAddr     types.String `tfsdk:"dev_addr"`
+	AppSKey     types.String `tfsdk:"app_s_key"`
+	NwkSEncKey  types.String `tfsdk:"nwk_s_enc_key"`
+	SNwkSIntKey types.String `tfsdk:"s_nwk_s_int_key"`
+	FNwkSIntKey types.String `tfsdk:"f_nwk_s_int_key"`
+	FCntUp      types.Int64  `tfsdk:"f_cnt_up"`
+	NFCntDown   types.Int64  `tfsdk:"n_f_cnt_down"`
+	AFCntDown   types.Int64  `tfsdk:"a_f_cnt_down"`
+}
+
+func (r *DeviceActivationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_activation"
+}
+
+func (r *DeviceActivationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Device activation resource. Activates a device using ABP (activation by personalization).
+
+For LoRaWAN 1.0.x devices only ` + "`nwk_s_enc_key`" + ` needs to be set, ` + "`s_nwk_s_int_key`" + ` and ` + "`f_nwk_s_int_key`" + ` default to the same value.
+The frame-counters are only applied on activation. Changes made to them by device traffic are not reported as drift.`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Device activation identifier. This is the same as the DevEUI.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dev_eui": schema.StringAttribute{
+				MarkdownDescription: "DevEUI (EUI64) of the device",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dev_addr": schema.StringAttribute{
+				MarkdownDescription: "Device address (HEX encoded)",
+				Required:            true,
+			},
+			"app_s_key": schema.StringAttribute{
+				MarkdownDescription: "Application session key (HEX encoded)",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"nwk_s_enc_key": schema.StringAttribute{
+				MarkdownDescription: "Network session encryption key (HEX encoded). Note: For LoRaWAN 1.0.x devices, use this for the NwkSKey.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"s_nwk_s_int_key": schema.StringAttribute{
+				MarkdownDescription: "Serving network session integrity key (HEX encoded). Defaults to `nwk_s_enc_key` (LoRaWAN 1.0.x).",
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"f_nwk_s_int_key": schema.StringAttribute{
+				MarkdownDescription: "Forwarding network session integrity key (HEX encoded). Defaults to `nwk_s_enc_key` (LoRaWAN 1.0.x).",
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"f_cnt_up": schema.Int64Attribute{
+				MarkdownDescription: "Uplink frame-counter",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"n_f_cnt_down": schema.Int64Attribute{
+				MarkdownDescription: "Downlink network frame-counter",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"a_f_cnt_down": schema.Int64Attribute{
+				MarkdownDescription: "Downlink application frame-counter",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DeviceActivationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	chirpstack, ok := req.ProviderData.(client.Chirpstack)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Chirpstack, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.chirpstack = chirpstack
+}
+
+func deviceActivationFromData(data *DeviceActivationResourceModel) *api.DeviceActivation {
+	activation := &api.DeviceActivation{
+		DevEui:      data.DevEui.ValueString(),
+		DevAddr:     data.DevAddr.ValueString(),
+		AppSKey:     data.AppSKey.ValueString(),
+		NwkSEncKey:  data.NwkSEncKey.ValueString(),
+		SNwkSIntKey: data.NwkSEncKey.ValueString(),
+		FNwkSIntKey: data.NwkSEncKey.ValueString(),
+	}
+
+	if !data.SNwkSIntKey.IsNull() && !data.SNwkSIntKey.IsUnknown() {
+		activation.SNwkSIntKey = data.SNwkSIntKey.ValueString()
+	}
+	if !data.FNwkSIntKey.IsNull() && !data.FNwkSIntKey.IsUnknown() {
+		activation.FNwkSIntKey = data.FNwkSIntKey.ValueString()
+	}
+	if !data.FCntUp.IsNull() && !data.FCntUp.IsUnknown() {
+		activation.FCntUp = uint32(data.FCntUp.ValueInt64())
+	}
+	if !data.NFCntDown.IsNull() && !data.NFCntDown.IsUnknown() {
+		activation.NFCntDown = uint32(data.NFCntDown.ValueInt64())
+	}
+	if !data.AFCntDown.IsNull() && !data.AFCntDown.IsUnknown() {
+		activation.AFCntDown = uint32(data.AFCntDown.ValueInt64())
+	}
+
+	return activation
+}
+
+func deviceActivationToData(activation *api.DeviceActivation, data *DeviceActivationResourceModel) {
+	data.Id = types.StringValue(activation.DevEui)
+	data.DevEui = types.StringValue(activation.DevEui)
+	data.DevAddr = types.StringValue(activation.DevAddr)
+	data.AppSKey = types.StringValue(activation.AppSKey)
+	data.NwkSEncKey = types.StringValue(activation.NwkSEncKey)
+	data.SNwkSIntKey = types.StringValue(activation.SNwkSIntKey)
+	data.FNwkSIntKey = types.StringValue(activation.FNwkSIntKey)
+
+	// The frame-counters move with every message, only take them from the
+	// server when they are not known yet (e.g. on import).
+	if data.FCntUp.IsNull() || data.FCntUp.IsUnknown() {
+		data.FCntUp = types.Int64Value(int64(activation.FCntUp))
+	}
+	if data.NFCntDown.IsNull() || data.NFCntDown.IsUnknown() {
+		data.NFCntDown = types.Int64Value(int64(activation.NFCntDown))
+	}
+	if data.AFCntDown.IsNull() || data.AFCntDown.IsUnknown() {
+		data.AFCntDown = types.Int64Value(int64(activation.AFCntDown))
+	}
+}
+
+func (r *DeviceActivationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DeviceActivationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	activation := deviceActivationFromData(&data)
+	err := r.chirpstack.ActivateDevice(ctx, activation)
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to activate device, got error: %s", err))
+		return
+	}
+	deviceActivationToData(activation, &data)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeviceActivationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DeviceActivationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	device, err := r.chirpstack.GetDevice(ctx, data.Id.ValueString())
+	// The device has been deleted outside of Terraform.
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read device activation, got error: %s", err))
+		return
+	}
+
+	// The device has been deactivated (or re-joined using OTAA) outside of Terraform.
+	if device.DeviceActivation == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	deviceActivationToData(device.DeviceActivation, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeviceActivationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DeviceActivationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Activating an already activated device replaces its session.
+	activation := deviceActivationFromData(&data)
+	err := r.chirpstack.ActivateDevice(ctx, activation)
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to activate device, got error: %s", err))
+		return
+	}
+	deviceActivationToData(activation, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeviceActivationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DeviceActivationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.chirpstack.DeactivateDevice(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to deactivate device, got error: %s", err))
+		return
+	}
+}
+
+func (r *DeviceActivationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeviceActivationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDeviceActivationResourceConfig("01020304"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("chirpstack_device_activation.test", "id", "010203040506070a"),
+					resource.TestCheckResourceAttr("chirpstack_device_activation.test", "dev_addr", "01020304"),
+					resource.TestCheckResourceAttr("chirpstack_device_activation.test", "s_nwk_s_int_key", "02020202020202020202020202020202"),
+					resource.TestCheckResourceAttr("chirpstack_device_activation.test", "f_nwk_s_int_key", "02020202020202020202020202020202"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "chirpstack_device_activation.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccDeviceActivationResourceConfig("04030201"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("chirpstack_device_activation.test", "dev_addr", "04030201"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDeviceActivationResourceConfig(devAddr string) string {
+	return fmt.Sprintf(`
+resource "chirpstack_tenant" "test" {
+  name = "test_tenant"
+}
+resource "chirpstack_application" "test" {
+  tenant_id = chirpstack_tenant.test.id
+  name      = "test_app"
+}
+resource "chirpstack_device_profile" "test" {
+  tenant_id                  = chirpstack_tenant.test.id
+  name                       = "test_device_profile"
+  region                     = "AU915"
+  region_parameters_revision = "A"
+  mac_version                = "LORAWAN_1_0_3"
+  device_supports_otaa       = false
+}
+resource "chirpstack_device" "test" {
+  dev_eui           = "010203040506070a"
+  name              = "test_device"
+  application_id    = chirpstack_application.test.id
+  device_profile_id = chirpstack_device_profile.test.id
+}
+resource "chirpstack_device_activation" "test" {
+  dev_eui       = chirpstack_device.test.dev_eui
+  dev_addr      = %[1]q
+  app_s_key     = "01010101010101010101010101010101"
+  nwk_s_enc_key = "02020202020202020202020202020202"
+}
+`, devAddr)
+}
@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/halter-corp/terraform-provider-chirpstack/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DeviceDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &DeviceDataSource{}
+
+func NewDeviceDataSource() datasource.DataSource {
+	return &DeviceDataSource{}
+}
+
+// DeviceDataSource defines the data source implementation.
+type DeviceDataSource struct {
+	chirpstack client.Chirpstack
+}
+
+func (d *DeviceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device"
+}
+
+func (d *DeviceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Device data source. Looks up a device by DevEUI, or by exact name within an application.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Device identifier. This is the same as the DevEUI.",
+				Computed:            true,
+			},
+			"dev_eui": schema.StringAttribute{
+				MarkdownDescription: "DevEUI (EUI64)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Device name",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("application_id")),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Device description",
+				Computed:            true,
+			},
+			"application_id": schema.StringAttribute{
+				MarkdownDescription: "Application ID. Required when looking up the device by name.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"device_profile_id": schema.StringAttribute{
+				MarkdownDescription: "Device profile ID",
+				Computed:            true,
+			},
+			"join_eui": schema.StringAttribute{
+				MarkdownDescription: "JoinEUI (EUI64)",
+				Computed:            true,
+			},
+			"skip_fcnt_check": schema.BoolAttribute{
+				MarkdownDescription: "Skip frame-counter checks (this is insecure, but could be helpful for debugging).",
+				Computed:            true,
+			},
+			"is_disabled": schema.BoolAttribute{
+				MarkdownDescription: "Device is disabled.",
+				Computed:            true,
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags (user defined).",
+				Computed:            true,
+			},
+			"variables": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Variables (user defined).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DeviceDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("dev_eui"), path.MatchRoot("name")),
+	}
+}
+
+func (d *DeviceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	chirpstack, ok := req.ProviderData.(client.Chirpstack)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Chirpstack, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.chirpstack = chirpstack
+}
+
+func (d *DeviceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DeviceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	devEui := data.DevEui.ValueString()
+	if data.DevEui.IsNull() {
+		name := data.Name.ValueString()
+		var matches []string
+		for device, err := range d.chirpstack.IterateDevices(ctx, data.ApplicationId.ValueString(), name) {
+			if err != nil {
+				resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to list devices, got error: %s", err))
+				return
+			}
+			if device.Name == name {
+				matches = append(matches, device.DevEui)
+			}
+		}
+		if len(matches) != 1 {
+			resp.Diagnostics.AddError("Device Not Found", fmt.Sprintf("Expected exactly one device with name %q, found %d", name, len(matches)))
+			return
+		}
+		devEui = matches[0]
+	}
+
+	device, err := d.chirpstack.GetDevice(ctx, devEui)
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read device, got error: %s", err))
+		return
+	}
+
+	deviceAttributesToData(device, &data)
+	data.Tags = stringMapToData(device.Device.Tags, data.Tags)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeviceDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccDeviceDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.chirpstack_device.by_dev_eui", "dev_eui", "chirpstack_device.test", "dev_eui"),
+					resource.TestCheckResourceAttrPair("data.chirpstack_device.by_name", "dev_eui", "chirpstack_device.test", "dev_eui"),
+					resource.TestCheckResourceAttr("data.chirpstack_device.by_dev_eui", "name", "datasource-device"),
+					resource.TestCheckResourceAttr("data.chirpstack_device.by_name", "name", "datasource-device"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeviceDataSourceConfig() string {
+	return testAccDeviceResourceConfig("datasource-device") + `
+data "chirpstack_device" "by_dev_eui" {
+  dev_eui = chirpstack_device.test.dev_eui
+}
+data "chirpstack_device" "by_name" {
+  name           = chirpstack_device.test.name
+  application_id = chirpstack_application.test.id
+}
+`
+}
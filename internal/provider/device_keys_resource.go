@@ -0,0 +1,229 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+	"github.com/halter-corp/terraform-provider-chirpstack/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DeviceKeysResource{}
+var _ resource.ResourceWithImportState = &DeviceKeysResource{}
+
+func NewDeviceKeysResource() resource.Resource {
+	return &DeviceKeysResource{}
+}
+
+// DeviceKeysResource defines the resource implementation.
+type DeviceKeysResource struct {
+	chirpstack client.Chirpstack
+}
+
+// DeviceKeysResourceModel describes the resource data model.
+type DeviceKeysResourceModel struct {
+	Id     types.String `tfsdk:"id"`
+	DevEui types.String `tfsdk:"dev_eui"`
+	NwkKey types.String `tfsdk:"nwk_key"`
+	AppKey types.String `tfsdk:"app_key"`
+}
+
+func (r *DeviceKeysResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_keys"
+}
+
+func (r *DeviceKeysResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Device keys resource. Manages the OTAA root keys of a device.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Device keys identifier. This is the same as the DevEUI.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dev_eui": schema.StringAttribute{
+				MarkdownDescription: "DevEUI (EUI64) of the device",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"nwk_key": schema.StringAttribute{
+				MarkdownDescription: "Network root key (128 bit). Note: For LoRaWAN 1.0.x, use this field for the LoRaWAN 1.0.x `AppKey`!",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"app_key": schema.StringAttribute{
+				MarkdownDescription: "Application root key (128 bit). Note: This field only needs to be set for LoRaWAN 1.1.x devices! Removing it clears the key.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *DeviceKeysResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	chirpstack, ok := req.ProviderData.(client.Chirpstack)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Chirpstack, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.chirpstack = chirpstack
+}
+
+func deviceKeysFromData(data *DeviceKeysResourceModel) *api.DeviceKeys {
+	keys := &api.DeviceKeys{
+		DevEui: data.DevEui.ValueString(),
+		NwkKey: data.NwkKey.ValueString(),
+	}
+	// A null app_key clears the key.
+	if !data.AppKey.IsUnknown() {
+		keys.AppKey = data.AppKey.ValueString()
+	}
+	return keys
+}
+
+func deviceKeysToData(keys *api.DeviceKeys, data *DeviceKeysResourceModel) {
+	data.Id = types.StringValue(keys.DevEui)
+	data.DevEui = types.StringValue(keys.DevEui)
+	data.NwkKey = types.StringValue(keys.NwkKey)
+	// Chirpstack returns an all-zero key when the key is not set.
+	if strings.Trim(keys.AppKey, "0") == "" {
+		data.AppKey = types.StringNull()
+	} else {
+		data.AppKey = types.StringValue(keys.AppKey)
+	}
+}
+
+func (r *DeviceKeysResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DeviceKeysResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.chirpstack.CreateDeviceKeys(ctx, deviceKeysFromData(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to create device keys, got error: %s", err))
+		return
+	}
+
+	// Read the keys back so that the server side default of app_key ends up in the state.
+	keys, err := r.chirpstack.GetDeviceKeys(ctx, data.DevEui.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read device keys, got error: %s", err))
+		return
+	}
+	deviceKeysToData(keys, &data)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeviceKeysResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DeviceKeysResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keys, err := r.chirpstack.GetDeviceKeys(ctx, data.Id.ValueString())
+	// The device keys have been deleted outside of Terraform.
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read device keys, got error: %s", err))
+		return
+	}
+
+	deviceKeysToData(keys, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeviceKeysResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DeviceKeysResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.chirpstack.UpdateDeviceKeys(ctx, deviceKeysFromData(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to update device keys, got error: %s", err))
+		return
+	}
+
+	keys, err := r.chirpstack.GetDeviceKeys(ctx, data.DevEui.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read device keys, got error: %s", err))
+		return
+	}
+	deviceKeysToData(keys, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeviceKeysResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DeviceKeysResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.chirpstack.DeleteDeviceKeys(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to delete device keys, got error: %s", err))
+		return
+	}
+}
+
+func (r *DeviceKeysResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeviceKeysResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDeviceKeysResourceConfig("01020304050607080102030405060708", "0f0e0d0c0b0a09080706050403020100"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("chirpstack_device_keys.test", "id", "0102030405060709"),
+					resource.TestCheckResourceAttr("chirpstack_device_keys.test", "nwk_key", "01020304050607080102030405060708"),
+					resource.TestCheckResourceAttr("chirpstack_device_keys.test", "app_key", "0f0e0d0c0b0a09080706050403020100"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "chirpstack_device_keys.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccDeviceKeysResourceConfig("08070605040302010807060504030201", "0f0e0d0c0b0a09080706050403020100"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("chirpstack_device_keys.test", "nwk_key", "08070605040302010807060504030201"),
+				),
+			},
+			// Removing app_key clears it
+			{
+				Config: testAccDeviceKeysResourceConfig("08070605040302010807060504030201", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("chirpstack_device_keys.test", "app_key"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDeviceKeysResourceConfig(nwkKey, appKey string) string {
+	appKeyConfig := ""
+	if appKey != "" {
+		appKeyConfig = fmt.Sprintf("app_key = %q", appKey)
+	}
+	return fmt.Sprintf(`
+resource "chirpstack_tenant" "test" {
+  name = "test_tenant"
+}
+resource "chirpstack_application" "test" {
+  tenant_id = chirpstack_tenant.test.id
+  name      = "test_app"
+}
+resource "chirpstack_device_profile" "test" {
+  tenant_id                  = chirpstack_tenant.test.id
+  name                       = "test_device_profile"
+  region                     = "AU915"
+  region_parameters_revision = "A"
+  mac_version                = "LORAWAN_1_1_0"
+  device_supports_otaa       = true
+}
+resource "chirpstack_device" "test" {
+  dev_eui           = "0102030405060709"
+  name              = "test_device"
+  application_id    = chirpstack_application.test.id
+  device_profile_id = chirpstack_device_profile.test.id
+}
+resource "chirpstack_device_keys" "test" {
+  dev_eui = chirpstack_device.test.dev_eui
+  nwk_key = %[1]q
+  %[2]s
+}
+`, nwkKey, appKeyConfig)
+}
@@ -0,0 +1,353 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/halter-corp/terraform-provider-chirpstack/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DeviceProfileDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &DeviceProfileDataSource{}
+
+func NewDeviceProfileDataSource() datasource.DataSource {
+	return &DeviceProfileDataSource{}
+}
+
+// DeviceProfileDataSource defines the data source implementation.
+type DeviceProfileDataSource struct {
+	chirpstack client.Chirpstack
+}
+
+func (d *DeviceProfileDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_profile"
+}
+
+func (d *DeviceProfileDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DeviceProfile data source. Looks up a device profile by ID, or by exact name within a tenant.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "DeviceProfile identifier",
+				Optional:            true,
+				Computed:            true,
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "Tenant ID. Required when looking up the device profile by name.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Device profile name",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("tenant_id")),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Device profile description",
+				Computed:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Device profile region",
+				Computed:            true,
+			},
+			"region_config_id": schema.StringAttribute{
+				MarkdownDescription: "Region configuration ID",
+				Computed:            true,
+			},
+			"mac_version": schema.StringAttribute{
+				MarkdownDescription: "The LoRaWAN MAC version supported by the device.",
+				Computed:            true,
+			},
+			"region_parameters_revision": schema.StringAttribute{
+				MarkdownDescription: "Revision of the Regional Parameters specification supported by the device.",
+				Computed:            true,
+			},
+			"adr_algorithm": schema.StringAttribute{
+				MarkdownDescription: "The ADR algorithm that will be used for controlling the device data-rate.",
+				Computed:            true,
+			},
+			"flush_queue_on_activate": schema.BoolAttribute{
+				MarkdownDescription: "Flush the device queue on (re)activation.",
+				Computed:            true,
+			},
+			"allow_roaming": schema.BoolAttribute{
+				MarkdownDescription: "If enabled (and if roaming is configured on the server), this allows the device to use roaming.",
+				Computed:            true,
+			},
+			"expected_uplink_interval": schema.Int64Attribute{
+				MarkdownDescription: "The expected interval in seconds in which the device sends uplink messages. This is used to determine if a device is active or inactive.",
+				Computed:            true,
+			},
+			"device_status_request_frequency": schema.Int64Attribute{
+				MarkdownDescription: "Frequency to initiate an End-Device status request (request/day). Set to 0 to disable.",
+				Computed:            true,
+			},
+			"device_supports_otaa": schema.BoolAttribute{
+				MarkdownDescription: "Device supports OTAA",
+				Computed:            true,
+			},
+			"device_supports_class_b": schema.BoolAttribute{
+				MarkdownDescription: "Device supports Class-B",
+				Computed:            true,
+			},
+			"device_supports_class_c": schema.BoolAttribute{
+				MarkdownDescription: "Device supports Class-C",
+				Computed:            true,
+			},
+			"class_b_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Class-B timeout (seconds). This is the maximum time ChirpStack will wait to receive an acknowledgement from the device (if requested).",
+				Computed:            true,
+			},
+			"class_b_ping_slot_nb_k": schema.Int64Attribute{
+				MarkdownDescription: "Class-B ping-slots per beacon period, as k where the number of ping-slots equals 2^k.",
+				Computed:            true,
+			},
+			"class_b_ping_slot_dr": schema.Int64Attribute{
+				MarkdownDescription: "Class-B ping-slot data-rate.",
+				Computed:            true,
+			},
+			"class_b_ping_slot_freq": schema.Int64Attribute{
+				MarkdownDescription: "Class-B ping-slot frequency (Hz).",
+				Computed:            true,
+			},
+			"class_c_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Class-C timeout (seconds). This is the maximum time ChirpStack will wait to receive an acknowledgement from the device (if requested).",
+				Computed:            true,
+			},
+			"abp_rx1_delay": schema.Int64Attribute{
+				MarkdownDescription: "RX1 delay (seconds) for ABP, 0 meaning 1 second.",
+				Computed:            true,
+			},
+			"abp_rx1_dr_offset": schema.Int64Attribute{
+				MarkdownDescription: "RX1 data-rate offset for ABP.",
+				Computed:            true,
+			},
+			"abp_rx2_dr": schema.Int64Attribute{
+				MarkdownDescription: "RX2 data-rate for ABP.",
+				Computed:            true,
+			},
+			"abp_rx2_freq": schema.Int64Attribute{
+				MarkdownDescription: "RX2 frequency (Hz) for ABP.",
+				Computed:            true,
+			},
+			"payload_codec_runtime": schema.StringAttribute{
+				MarkdownDescription: "Payload codec runtime. One of `NONE`, `CAYENNE_LPP` or `JS`.",
+				Computed:            true,
+			},
+			"payload_codec_script": schema.StringAttribute{
+				MarkdownDescription: "JavaScript payload codec.",
+				Computed:            true,
+			},
+			"measurements": schema.MapNestedAttribute{
+				MarkdownDescription: "Measurements of the decoded payload, keyed by the path of the measurement in the decoded object.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name (user defined).",
+							Computed:            true,
+						},
+						"kind": schema.StringAttribute{
+							MarkdownDescription: "Measurement kind. One of `UNKNOWN`, `COUNTER`, `ABSOLUTE`, `GAUGE` or `STRING`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"auto_detect_measurements": schema.BoolAttribute{
+				MarkdownDescription: "Chirpstack adds the keys of decoded payloads to `measurements`.",
+				Computed:            true,
+			},
+			"relay": schema.SingleNestedAttribute{
+				MarkdownDescription: "Relay (TS011) settings. Not set if relaying is disabled.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"is_relay": schema.BoolAttribute{
+						MarkdownDescription: "Device is a relay. A relay device implements TS011 and is able to relay data from relay capable end-devices.",
+						Computed:            true,
+					},
+					"is_relay_ed": schema.BoolAttribute{
+						MarkdownDescription: "Device is an end-device that can operate under a relay.",
+						Computed:            true,
+					},
+					"ed_relay_only": schema.BoolAttribute{
+						MarkdownDescription: "Only accept data for this end-device through a relay. This is useful for testing, as the end-device is usually within range of a gateway.",
+						Computed:            true,
+					},
+					"enabled": schema.BoolAttribute{
+						MarkdownDescription: "Relay must be enabled.",
+						Computed:            true,
+					},
+					"cad_periodicity": schema.StringAttribute{
+						MarkdownDescription: "Relay CAD periodicity. One of `SEC_1`, `MS_500`, `MS_250`, `MS_100`, `MS_50` or `MS_20`.",
+						Computed:            true,
+					},
+					"default_channel_index": schema.Int64Attribute{
+						MarkdownDescription: "Relay default channel index. Valid values are 0 and 1, please refer to the RP002 specification for the meaning of these values.",
+						Computed:            true,
+					},
+					"second_channel_freq": schema.Int64Attribute{
+						MarkdownDescription: "Relay second channel frequency (Hz), 0 to disable the second channel.",
+						Computed:            true,
+					},
+					"second_channel_dr": schema.Int64Attribute{
+						MarkdownDescription: "Relay second channel data-rate.",
+						Computed:            true,
+					},
+					"second_channel_ack_offset": schema.StringAttribute{
+						MarkdownDescription: "Relay second channel ACK offset. One of `KHZ_0`, `KHZ_200`, `KHZ_400`, `KHZ_800`, `KHZ_1600` or `KHZ_3200`.",
+						Computed:            true,
+					},
+					"ed_activation_mode": schema.StringAttribute{
+						MarkdownDescription: "Relay end-device activation mode. One of `DISABLE_RELAY_MODE`, `ENABLE_RELAY_MODE`, `DYNAMIC` or `END_DEVICE_CONTROLLED`.",
+						Computed:            true,
+					},
+					"ed_smart_enable_level": schema.Int64Attribute{
+						MarkdownDescription: "Relay end-device smart-enable level (0 - 3).",
+						Computed:            true,
+					},
+					"ed_back_off": schema.Int64Attribute{
+						MarkdownDescription: "Relay end-device back-off, in case it does not receive a WOR ACK frame. 0 to always send a LoRaWAN uplink, 1 - 63 to send a LoRaWAN uplink after X WOR frames without a WOR ACK.",
+						Computed:            true,
+					},
+					"ed_uplink_limit_bucket_size": schema.Int64Attribute{
+						MarkdownDescription: "Relay end-device uplink limit bucket size multiplier (0 - 3, meaning 1, 2, 4 or 12).",
+						Computed:            true,
+					},
+					"ed_uplink_limit_reload_rate": schema.Int64Attribute{
+						MarkdownDescription: "Relay end-device uplink limit reload rate. 0 - 62 tokens every hour, or 63 for no limitation.",
+						Computed:            true,
+					},
+					"join_req_limit_bucket_size": schema.Int64Attribute{
+						MarkdownDescription: "Relay join-request limit bucket size multiplier (0 - 3, meaning 1, 2, 4 or 12).",
+						Computed:            true,
+					},
+					"join_req_limit_reload_rate": schema.Int64Attribute{
+						MarkdownDescription: "Relay join-request limit reload rate. 0 - 126 tokens every hour, or 127 for no limitation.",
+						Computed:            true,
+					},
+					"notify_limit_bucket_size": schema.Int64Attribute{
+						MarkdownDescription: "Relay notify limit bucket size multiplier (0 - 3, meaning 1, 2, 4 or 12).",
+						Computed:            true,
+					},
+					"notify_limit_reload_rate": schema.Int64Attribute{
+						MarkdownDescription: "Relay notify limit reload rate. 0 - 126 tokens every hour, or 127 for no limitation.",
+						Computed:            true,
+					},
+					"global_uplink_limit_bucket_size": schema.Int64Attribute{
+						MarkdownDescription: "Relay global uplink limit bucket size multiplier (0 - 3, meaning 1, 2, 4 or 12).",
+						Computed:            true,
+					},
+					"global_uplink_limit_reload_rate": schema.Int64Attribute{
+						MarkdownDescription: "Relay global uplink limit reload rate. 0 - 126 tokens every hour, or 127 for no limitation.",
+						Computed:            true,
+					},
+					"overall_limit_bucket_size": schema.Int64Attribute{
+						MarkdownDescription: "Relay overall limit bucket size multiplier (0 - 3, meaning 1, 2, 4 or 12).",
+						Computed:            true,
+					},
+					"overall_limit_reload_rate": schema.Int64Attribute{
+						MarkdownDescription: "Relay overall limit reload rate. 0 - 126 tokens every hour, or 127 for no limitation.",
+						Computed:            true,
+					},
+				},
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags (user defined).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DeviceProfileDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
+func (d *DeviceProfileDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	chirpstack, ok := req.ProviderData.(client.Chirpstack)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Chirpstack, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.chirpstack = chirpstack
+}
+
+func (d *DeviceProfileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DeviceProfileModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.Id.ValueString()
+	if data.Id.IsNull() {
+		name := data.Name.ValueString()
+		var matches []string
+		for deviceProfile, err := range d.chirpstack.IterateDeviceProfiles(ctx, data.TenantId.ValueString(), name) {
+			if err != nil {
+				resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to list device profiles, got error: %s", err))
+				return
+			}
+			if deviceProfile.Name == name {
+				matches = append(matches, deviceProfile.Id)
+			}
+		}
+		if len(matches) != 1 {
+			resp.Diagnostics.AddError("Device Profile Not Found", fmt.Sprintf("Expected exactly one device profile with name %q, found %d", name, len(matches)))
+			return
+		}
+		id = matches[0]
+	}
+
+	deviceProfile, err := d.chirpstack.GetDeviceProfile(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read device profile, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(deviceProfile.Id)
+	// All measurements are returned, including auto-detected ones.
+	deviceProfileAttributesToData(deviceProfile, &data, false)
+	data.Tags = stringMapToData(deviceProfile.Tags, data.Tags)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeviceProfileDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccDeviceProfileDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.chirpstack_device_profile.by_id", "id", "chirpstack_device_profile.test", "id"),
+					resource.TestCheckResourceAttrPair("data.chirpstack_device_profile.by_name", "id", "chirpstack_device_profile.test", "id"),
+					resource.TestCheckResourceAttr("data.chirpstack_device_profile.by_id", "name", "datasource-deviceprofile"),
+					resource.TestCheckResourceAttr("data.chirpstack_device_profile.by_name", "name", "datasource-deviceprofile"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeviceProfileDataSourceConfig() string {
+	return testAccDeviceProfileResourceConfig("datasource-deviceprofile") + `
+data "chirpstack_device_profile" "by_id" {
+  id = chirpstack_device_profile.test.id
+}
+data "chirpstack_device_profile" "by_name" {
+  name      = chirpstack_device_profile.test.name
+  tenant_id = chirpstack_tenant.test.id
+}
+`
+}
@@ -5,21 +5,29 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/chirpstack/chirpstack/api/go/v4/api"
 	"github.com/chirpstack/chirpstack/api/go/v4/common"
 	"github.com/halter-corp/terraform-provider-chirpstack/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &DeviceProfileResource{}
+var _ resource.ResourceWithModifyPlan = &DeviceProfileResource{}
+var _ resource.ResourceWithValidateConfig = &DeviceProfileResource{}
 var _ resource.ResourceWithImportState = &DeviceProfileResource{}
 
 func NewDeviceProfileResource() resource.Resource {
@@ -28,28 +36,51 @@ func NewDeviceProfileResource() resource.Resource {
 
 // DeviceProfileResource defines the resource implementation.
 type DeviceProfileResource struct {
-	chirpstack client.Chirpstack
+	chirpstack  client.Chirpstack
+	defaultTags defaultTags
+}
+
+// DeviceProfileModel describes the attributes shared by the device profile
+// resource and data source.
+type DeviceProfileModel struct {
+	Id                           types.String             `tfsdk:"id"`
+	TenantId                     types.String             `tfsdk:"tenant_id"`
+	Name                         types.String             `tfsdk:"name"`
+	Description                  types.String             `tfsdk:"description"`
+	Region                       types.String             `tfsdk:"region"`
+	RegionConfigId               types.String             `tfsdk:"region_config_id"`
+	RegionParametersRevision     types.String             `tfsdk:"region_parameters_revision"`
+	MacVersion                   types.String             `tfsdk:"mac_version"`
+	AdrAlgorithm                 types.String             `tfsdk:"adr_algorithm"`
+	FlushQueueOnActivate         types.Bool               `tfsdk:"flush_queue_on_activate"`
+	AllowRoaming                 types.Bool               `tfsdk:"allow_roaming"`
+	ExpectedUplinkInterval       types.Int64              `tfsdk:"expected_uplink_interval"`
+	DeviceStatusRequestFrequency types.Int64              `tfsdk:"device_status_request_frequency"`
+	DeviceSupportsOTAA           types.Bool               `tfsdk:"device_supports_otaa"`
+	DeviceSupportsClassB         types.Bool               `tfsdk:"device_supports_class_b"`
+	DeviceSupportsClassC         types.Bool               `tfsdk:"device_supports_class_c"`
+	ClassBTimeout                types.Int64              `tfsdk:"class_b_timeout"`
+	ClassBPingSlotNbK            types.Int64              `tfsdk:"class_b_ping_slot_nb_k"`
+	ClassBPingSlotDr             types.Int64              `tfsdk:"class_b_ping_slot_dr"`
+	ClassBPingSlotFreq           types.Int64              `tfsdk:"class_b_ping_slot_freq"`
+	ClassCTimeout                types.Int64              `tfsdk:"class_c_timeout"`
+	AbpRx1Delay                  types.Int64              `tfsdk:"abp_rx1_delay"`
+	AbpRx1DrOffset               types.Int64              `tfsdk:"abp_rx1_dr_offset"`
+	AbpRx2Dr                     types.Int64              `tfsdk:"abp_rx2_dr"`
+	AbpRx2Freq                   types.Int64              `tfsdk:"abp_rx2_freq"`
+	PayloadCodecRuntime          types.String             `tfsdk:"payload_codec_runtime"`
+	PayloadCodecScript           types.String             `tfsdk:"payload_codec_script"`
+	Measurements                 types.Map                `tfsdk:"measurements"`
+	AutoDetectMeasurements       types.Bool               `tfsdk:"auto_detect_measurements"`
+	Relay                        *DeviceProfileRelayModel `tfsdk:"relay"`
+	Tags                         types.Map                `tfsdk:"tags"`
 }
 
 // DeviceProfileResourceModel describes the resource data model.
 type DeviceProfileResourceModel struct {
-	Id                           types.String `tfsdk:"id"`
-	TenantId                     types.String `tfsdk:"tenant_id"`
-	Name                         types.String `tfsdk:"name"`
-	Description                  types.String `tfsdk:"description"`
-	Region                       types.String `tfsdk:"region"`
-	RegionConfigId               types.String `tfsdk:"region_config_id"`
-	RegionParametersRevision     types.String `tfsdk:"region_parameters_revision"`
-	MacVersion                   types.String `tfsdk:"mac_version"`
-	AdrAlgorithm                 types.String `tfsdk:"adr_algorithm"`
-	FlushQueueOnActivate         types.Bool   `tfsdk:"flush_queue_on_activate"`
-	AllowRoaming                 types.Bool   `tfsdk:"allow_roaming"`
-	ExpectedUplinkInterval       types.Int64  `tfsdk:"expected_uplink_interval"`
-	DeviceStatusRequestFrequency types.Int64  `tfsdk:"device_status_request_frequency"`
-	DeviceSupportsOTAA           types.Bool   `tfsdk:"device_supports_otaa"`
-	DeviceSupportsClassB         types.Bool   `tfsdk:"device_supports_class_b"`
-	DeviceSupportsClassC         types.Bool   `tfsdk:"device_supports_class_c"`
-	ClassCTimeout                types.Int64  `tfsdk:"class_c_timeout"`
+	DeviceProfileModel
+	IgnoreAutoDetectedMeasurements types.Bool `tfsdk:"ignore_auto_detected_measurements"`
+	TagsAll                        types.Map  `tfsdk:"tags_all"`
 }
 
 func (r *DeviceProfileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -82,20 +113,29 @@ func (r *DeviceProfileResource) Schema(ctx context.Context, req resource.SchemaR
 				Optional:            true,
 			},
 			"region": schema.StringAttribute{
-				MarkdownDescription: "Device profile region",
+				MarkdownDescription: "Device profile region, e.g. `EU868`, `US915` or `AU915`. The region must be enabled on the Chirpstack server.",
 				Required:            true,
+				Validators: []validator.String{
+					enumOf(common.Region_name),
+				},
 			},
 			"region_config_id": schema.StringAttribute{
-				MarkdownDescription: "Region configuration ID",
+				MarkdownDescription: "Region configuration ID. Must be one of the region configurations of `region` enabled on the Chirpstack server, see the `chirpstack_regions` data source.",
 				Optional:            true,
 			},
 			"mac_version": schema.StringAttribute{
-				MarkdownDescription: "The LoRaWAN MAC version supported by the device.",
+				MarkdownDescription: "The LoRaWAN MAC version supported by the device, e.g. `LORAWAN_1_0_3`.",
 				Required:            true,
+				Validators: []validator.String{
+					enumOf(common.MacVersion_name),
+				},
 			},
 			"region_parameters_revision": schema.StringAttribute{
-				MarkdownDescription: "Revision of the Regional Parameters specification supported by the device.",
+				MarkdownDescription: "Revision of the Regional Parameters specification supported by the device, e.g. `A` or `RP002_1_0_3`. Must be valid for `mac_version`.",
 				Required:            true,
+				Validators: []validator.String{
+					enumOf(common.RegParamsRevision_name),
+				},
 			},
 			"adr_algorithm": schema.StringAttribute{
 				MarkdownDescription: "The ADR algorithm that will be used for controlling the device data-rate.",
@@ -129,15 +169,390 @@ func (r *DeviceProfileResource) Schema(ctx context.Context, req resource.SchemaR
 				MarkdownDescription: "Device supports Class-C",
 				Optional:            true,
 			},
+			"class_b_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Class-B timeout (seconds). This is the maximum time ChirpStack will wait to receive an acknowledgement from the device (if requested). Requires `device_supports_class_b`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					onlyWhen(path.MatchRoot("device_supports_class_b"), types.BoolValue(true)),
+					int64validator.AtLeast(0),
+				},
+			},
+			"class_b_ping_slot_nb_k": schema.Int64Attribute{
+				MarkdownDescription: "Class-B ping-slots per beacon period, as k where the number of ping-slots equals 2^k. Valid options are 0 - 7. Requires `device_supports_class_b`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					onlyWhen(path.MatchRoot("device_supports_class_b"), types.BoolValue(true)),
+					int64validator.Between(0, 7),
+				},
+			},
+			"class_b_ping_slot_dr": schema.Int64Attribute{
+				MarkdownDescription: "Class-B ping-slot data-rate. Requires `device_supports_class_b`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					onlyWhen(path.MatchRoot("device_supports_class_b"), types.BoolValue(true)),
+					int64validator.Between(0, 15),
+				},
+			},
+			"class_b_ping_slot_freq": schema.Int64Attribute{
+				MarkdownDescription: "Class-B ping-slot frequency (Hz). Requires `device_supports_class_b`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					onlyWhen(path.MatchRoot("device_supports_class_b"), types.BoolValue(true)),
+					int64validator.AtLeast(0),
+				},
+			},
 			"class_c_timeout": schema.Int64Attribute{
 				MarkdownDescription: "Class-C timeout (seconds). This is the maximum time ChirpStack will wait to receive an acknowledgement from the device (if requested).",
 				Optional:            true,
 				Computed:            true,
 			},
+			"abp_rx1_delay": schema.Int64Attribute{
+				MarkdownDescription: "RX1 delay (seconds) for ABP. Valid options are 0 - 15, 0 meaning 1 second. Can only be set when `device_supports_otaa` is disabled.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					onlyWhen(path.MatchRoot("device_supports_otaa"), types.BoolValue(false), types.BoolNull()),
+					int64validator.Between(0, 15),
+				},
+			},
+			"abp_rx1_dr_offset": schema.Int64Attribute{
+				MarkdownDescription: "RX1 data-rate offset for ABP. Can only be set when `device_supports_otaa` is disabled.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					onlyWhen(path.MatchRoot("device_supports_otaa"), types.BoolValue(false), types.BoolNull()),
+					int64validator.Between(0, 7),
+				},
+			},
+			"abp_rx2_dr": schema.Int64Attribute{
+				MarkdownDescription: "RX2 data-rate for ABP. Can only be set when `device_supports_otaa` is disabled.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					onlyWhen(path.MatchRoot("device_supports_otaa"), types.BoolValue(false), types.BoolNull()),
+					int64validator.Between(0, 15),
+				},
+			},
+			"abp_rx2_freq": schema.Int64Attribute{
+				MarkdownDescription: "RX2 frequency (Hz) for ABP. Can only be set when `device_supports_otaa` is disabled.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					onlyWhen(path.MatchRoot("device_supports_otaa"), types.BoolValue(false), types.BoolNull()),
+					int64validator.AtLeast(0),
+				},
+			},
+			"payload_codec_runtime": schema.StringAttribute{
+				MarkdownDescription: "Payload codec runtime. One of `NONE`, `CAYENNE_LPP` or `JS`. Defaults to `NONE`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(api.CodecRuntime_NONE.String()),
+				Validators: []validator.String{
+					enumOf(api.CodecRuntime_name),
+				},
+			},
+			"payload_codec_script": schema.StringAttribute{
+				MarkdownDescription: "JavaScript payload codec, implementing `decodeUplink` and `encodeDownlink`. Requires `payload_codec_runtime` to be `JS`. Differences in line endings and trailing whitespace are ignored.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					onlyWhen(path.MatchRoot("payload_codec_runtime"), types.StringValue(api.CodecRuntime_JS.String())),
+				},
+				PlanModifiers: []planmodifier.String{
+					normalizedScriptModifier{},
+				},
+			},
+			"measurements": schema.MapNestedAttribute{
+				MarkdownDescription: "Measurements of the decoded payload, keyed by the path of the measurement in the decoded object (e.g. `temperature` or `sensors_0_value`).",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name (user defined).",
+							Optional:            true,
+						},
+						"kind": schema.StringAttribute{
+							MarkdownDescription: "Measurement kind. One of `COUNTER`, `ABSOLUTE`, `GAUGE` or `STRING`.",
+							Required:            true,
+							Validators: []validator.String{
+								enumOf(api.MeasurementKind_name, api.MeasurementKind_UNKNOWN.String()),
+							},
+						},
+					},
+				},
+			},
+			"auto_detect_measurements": schema.BoolAttribute{
+				MarkdownDescription: "If enabled, Chirpstack adds the keys of decoded payloads to `measurements`, with kind `UNKNOWN`. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"ignore_auto_detected_measurements": schema.BoolAttribute{
+				MarkdownDescription: "If enabled, measurements which are not in the configuration (e.g. auto-detected by Chirpstack) are ignored instead of being shown as drift, and are kept when updating the device profile. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"relay": schema.SingleNestedAttribute{
+				MarkdownDescription: "Relay (TS011) settings. Relaying is disabled if not set.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"is_relay": schema.BoolAttribute{
+						MarkdownDescription: "Device is a relay. A relay device implements TS011 and is able to relay data from relay capable end-devices. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"is_relay_ed": schema.BoolAttribute{
+						MarkdownDescription: "Device is an end-device that can operate under a relay. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"ed_relay_only": schema.BoolAttribute{
+						MarkdownDescription: "Only accept data for this end-device through a relay. This is useful for testing, as the end-device is usually within range of a gateway. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"enabled": schema.BoolAttribute{
+						MarkdownDescription: "Relay must be enabled. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"cad_periodicity": schema.StringAttribute{
+						MarkdownDescription: "Relay CAD periodicity. One of `SEC_1`, `MS_500`, `MS_250`, `MS_100`, `MS_50` or `MS_20`. Defaults to `SEC_1`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("SEC_1"),
+						Validators: []validator.String{
+							enumOf(api.CadPeriodicity_name),
+						},
+					},
+					"default_channel_index": schema.Int64Attribute{
+						MarkdownDescription: "Relay default channel index. Valid values are 0 and 1, please refer to the RP002 specification for the meaning of these values. Defaults to `0`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(0),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 1),
+						},
+					},
+					"second_channel_freq": schema.Int64Attribute{
+						MarkdownDescription: "Relay second channel frequency (Hz), 0 to disable the second channel. Defaults to `0`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(0),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"second_channel_dr": schema.Int64Attribute{
+						MarkdownDescription: "Relay second channel data-rate. Defaults to `0`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(0),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 15),
+						},
+					},
+					"second_channel_ack_offset": schema.StringAttribute{
+						MarkdownDescription: "Relay second channel ACK offset. One of `KHZ_0`, `KHZ_200`, `KHZ_400`, `KHZ_800`, `KHZ_1600` or `KHZ_3200`. Defaults to `KHZ_0`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("KHZ_0"),
+						Validators: []validator.String{
+							enumOf(api.SecondChAckOffset_name),
+						},
+					},
+					"ed_activation_mode": schema.StringAttribute{
+						MarkdownDescription: "Relay end-device activation mode. One of `DISABLE_RELAY_MODE`, `ENABLE_RELAY_MODE`, `DYNAMIC` or `END_DEVICE_CONTROLLED`. Defaults to `DISABLE_RELAY_MODE`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("DISABLE_RELAY_MODE"),
+						Validators: []validator.String{
+							enumOf(api.RelayModeActivation_name),
+						},
+					},
+					"ed_smart_enable_level": schema.Int64Attribute{
+						MarkdownDescription: "Relay end-device smart-enable level (0 - 3). Defaults to `0`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(0),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 3),
+						},
+					},
+					"ed_back_off": schema.Int64Attribute{
+						MarkdownDescription: "Relay end-device back-off, in case it does not receive a WOR ACK frame. 0 to always send a LoRaWAN uplink, 1 - 63 to send a LoRaWAN uplink after X WOR frames without a WOR ACK. Defaults to `0`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(0),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 63),
+						},
+					},
+					"ed_uplink_limit_bucket_size": schema.Int64Attribute{
+						MarkdownDescription: "Relay end-device uplink limit bucket size multiplier (0 - 3, meaning 1, 2, 4 or 12). Defaults to `0`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(0),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 3),
+						},
+					},
+					"ed_uplink_limit_reload_rate": schema.Int64Attribute{
+						MarkdownDescription: "Relay end-device uplink limit reload rate. 0 - 62 tokens every hour, or 63 for no limitation. Defaults to `0`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(0),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 63),
+						},
+					},
+					"join_req_limit_bucket_size": schema.Int64Attribute{
+						MarkdownDescription: "Relay join-request limit bucket size multiplier (0 - 3, meaning 1, 2, 4 or 12). Defaults to `0`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(0),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 3),
+						},
+					},
+					"join_req_limit_reload_rate": schema.Int64Attribute{
+						MarkdownDescription: "Relay join-request limit reload rate. 0 - 126 tokens every hour, or 127 for no limitation. Defaults to `0`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(0),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 127),
+						},
+					},
+					"notify_limit_bucket_size": schema.Int64Attribute{
+						MarkdownDescription: "Relay notify limit bucket size multiplier (0 - 3, meaning 1, 2, 4 or 12). Defaults to `0`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(0),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 3),
+						},
+					},
+					"notify_limit_reload_rate": schema.Int64Attribute{
+						MarkdownDescription: "Relay notify limit reload rate. 0 - 126 tokens every hour, or 127 for no limitation. Defaults to `0`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(0),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 127),
+						},
+					},
+					"global_uplink_limit_bucket_size": schema.Int64Attribute{
+						MarkdownDescription: "Relay global uplink limit bucket size multiplier (0 - 3, meaning 1, 2, 4 or 12). Defaults to `0`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(0),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 3),
+						},
+					},
+					"global_uplink_limit_reload_rate": schema.Int64Attribute{
+						MarkdownDescription: "Relay global uplink limit reload rate. 0 - 126 tokens every hour, or 127 for no limitation. Defaults to `0`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(0),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 127),
+						},
+					},
+					"overall_limit_bucket_size": schema.Int64Attribute{
+						MarkdownDescription: "Relay overall limit bucket size multiplier (0 - 3, meaning 1, 2, 4 or 12). Defaults to `0`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(0),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 3),
+						},
+					},
+					"overall_limit_reload_rate": schema.Int64Attribute{
+						MarkdownDescription: "Relay overall limit reload rate. 0 - 126 tokens every hour, or 127 for no limitation. Defaults to `0`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(0),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 127),
+						},
+					},
+				},
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags (user defined). These tags are exposed in the event payloads or to integration. Tags are intended for aggregation and filtering.",
+				Optional:            true,
+			},
+			"tags_all": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "All tags of the resource, including those inherited from the provider `default_tags`.",
+				Computed:            true,
+			},
 		},
 	}
 }
 
+// regParamsRevisions are the Regional Parameters revisions which are valid
+// for each LoRaWAN MAC version.
+var regParamsRevisions = map[common.MacVersion][]common.RegParamsRevision{
+	common.MacVersion_LORAWAN_1_0_0: {common.RegParamsRevision_A},
+	common.MacVersion_LORAWAN_1_0_1: {common.RegParamsRevision_A},
+	common.MacVersion_LORAWAN_1_0_2: {common.RegParamsRevision_A, common.RegParamsRevision_B},
+	common.MacVersion_LORAWAN_1_0_3: {common.RegParamsRevision_A},
+	common.MacVersion_LORAWAN_1_0_4: {
+		common.RegParamsRevision_RP002_1_0_0, common.RegParamsRevision_RP002_1_0_1, common.RegParamsRevision_RP002_1_0_2,
+		common.RegParamsRevision_RP002_1_0_3, common.RegParamsRevision_RP002_1_0_4,
+	},
+	common.MacVersion_LORAWAN_1_1_0: {
+		common.RegParamsRevision_A, common.RegParamsRevision_B,
+		common.RegParamsRevision_RP002_1_0_0, common.RegParamsRevision_RP002_1_0_1, common.RegParamsRevision_RP002_1_0_2,
+		common.RegParamsRevision_RP002_1_0_3, common.RegParamsRevision_RP002_1_0_4,
+	},
+}
+
+func (r *DeviceProfileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var macVersion, revision types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("mac_version"), &macVersion)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("region_parameters_revision"), &revision)...)
+
+	if resp.Diagnostics.HasError() || macVersion.IsNull() || macVersion.IsUnknown() || revision.IsNull() || revision.IsUnknown() {
+		return
+	}
+
+	// Unknown names are reported by the attribute validators.
+	version, ok := common.MacVersion_value[macVersion.ValueString()]
+	if !ok {
+		return
+	}
+	if _, ok := common.RegParamsRevision_value[revision.ValueString()]; !ok {
+		return
+	}
+	var valid []string
+	for _, validRevision := range regParamsRevisions[common.MacVersion(version)] {
+		if validRevision.String() == revision.ValueString() {
+			return
+		}
+		valid = append(valid, validRevision.String())
+	}
+	resp.Diagnostics.AddAttributeError(
+		path.Root("region_parameters_revision"),
+		"Invalid Attribute Combination",
+		fmt.Sprintf("Regional Parameters revision %s is not valid for MAC version %s, expected one of: %s.", revision.ValueString(), macVersion.ValueString(), strings.Join(valid, ", ")),
+	)
+}
+
 func (r *DeviceProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -156,6 +571,12 @@ func (r *DeviceProfileResource) Configure(ctx context.Context, req resource.Conf
 	}
 
 	r.chirpstack = chirpstack
+	r.defaultTags = defaultTagsFromProviderData(req.ProviderData)
+}
+
+func (r *DeviceProfileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.modifyPlan(ctx, req, resp)
+	validatePlannedRegion(ctx, r.chirpstack, req, resp, true)
 }
 
 func deviceProfileFromData(data *DeviceProfileResourceModel) *api.DeviceProfile {
@@ -204,12 +625,30 @@ func deviceProfileFromData(data *DeviceProfileResourceModel) *api.DeviceProfile
 	if !data.DeviceSupportsClassC.IsNull() {
 		deviceProfile.SupportsClassC = data.DeviceSupportsClassC.ValueBool()
 	}
+	deviceProfile.ClassBTimeout = uint32(data.ClassBTimeout.ValueInt64())
+	deviceProfile.ClassBPingSlotNbK = uint32(data.ClassBPingSlotNbK.ValueInt64())
+	deviceProfile.ClassBPingSlotDr = uint32(data.ClassBPingSlotDr.ValueInt64())
+	deviceProfile.ClassBPingSlotFreq = uint32(data.ClassBPingSlotFreq.ValueInt64())
 	deviceProfile.ClassCTimeout = uint32(data.ClassCTimeout.ValueInt64())
+	deviceProfile.AbpRx1Delay = uint32(data.AbpRx1Delay.ValueInt64())
+	deviceProfile.AbpRx1DrOffset = uint32(data.AbpRx1DrOffset.ValueInt64())
+	deviceProfile.AbpRx2Dr = uint32(data.AbpRx2Dr.ValueInt64())
+	deviceProfile.AbpRx2Freq = uint32(data.AbpRx2Freq.ValueInt64())
+	if !data.PayloadCodecRuntime.IsNull() {
+		deviceProfile.PayloadCodecRuntime = api.CodecRuntime(api.CodecRuntime_value[data.PayloadCodecRuntime.ValueString()])
+	}
+	deviceProfile.PayloadCodecScript = data.PayloadCodecScript.ValueString()
+	deviceProfile.Measurements = measurementsFromData(data.Measurements)
+	deviceProfile.AutoDetectMeasurements = data.AutoDetectMeasurements.ValueBool()
+	relayFromData(data.Relay, deviceProfile)
+	deviceProfile.Tags = stringMapFromData(data.TagsAll)
 
 	return deviceProfile
 }
 
-func deviceProfileToData(deviceProfile *api.DeviceProfile, data *DeviceProfileResourceModel) {
+// deviceProfileAttributesToData sets the attributes shared by the device
+// profile resource and data source, except for the tags.
+func deviceProfileAttributesToData(deviceProfile *api.DeviceProfile, data *DeviceProfileModel, ignoreAutoDetectedMeasurements bool) {
 	data.TenantId = types.StringValue(deviceProfile.TenantId)
 	data.Name = types.StringValue(deviceProfile.Name)
 	if deviceProfile.Description != "" {
@@ -233,7 +672,28 @@ func deviceProfileToData(deviceProfile *api.DeviceProfile, data *DeviceProfileRe
 	data.DeviceSupportsOTAA = types.BoolValue(deviceProfile.SupportsOtaa)
 	data.DeviceSupportsClassB = types.BoolValue(deviceProfile.SupportsClassB)
 	data.DeviceSupportsClassC = types.BoolValue(deviceProfile.SupportsClassC)
+	data.ClassBTimeout = types.Int64Value(int64(deviceProfile.ClassBTimeout))
+	data.ClassBPingSlotNbK = types.Int64Value(int64(deviceProfile.ClassBPingSlotNbK))
+	data.ClassBPingSlotDr = types.Int64Value(int64(deviceProfile.ClassBPingSlotDr))
+	data.ClassBPingSlotFreq = types.Int64Value(int64(deviceProfile.ClassBPingSlotFreq))
 	data.ClassCTimeout = types.Int64Value(int64(deviceProfile.ClassCTimeout))
+	data.AbpRx1Delay = types.Int64Value(int64(deviceProfile.AbpRx1Delay))
+	data.AbpRx1DrOffset = types.Int64Value(int64(deviceProfile.AbpRx1DrOffset))
+	data.AbpRx2Dr = types.Int64Value(int64(deviceProfile.AbpRx2Dr))
+	data.AbpRx2Freq = types.Int64Value(int64(deviceProfile.AbpRx2Freq))
+	data.PayloadCodecRuntime = types.StringValue(deviceProfile.PayloadCodecRuntime.String())
+	data.PayloadCodecScript = scriptToData(deviceProfile.PayloadCodecScript, data.PayloadCodecScript)
+	data.Measurements = measurementsToData(deviceProfile.Measurements, data.Measurements, ignoreAutoDetectedMeasurements)
+	data.AutoDetectMeasurements = types.BoolValue(deviceProfile.AutoDetectMeasurements)
+	data.Relay = relayToData(deviceProfile, data.Relay)
+}
+
+func deviceProfileToData(deviceProfile *api.DeviceProfile, data *DeviceProfileResourceModel, defaults defaultTags) {
+	if data.IgnoreAutoDetectedMeasurements.IsNull() {
+		data.IgnoreAutoDetectedMeasurements = types.BoolValue(false)
+	}
+	deviceProfileAttributesToData(deviceProfile, &data.DeviceProfileModel, data.IgnoreAutoDetectedMeasurements.ValueBool())
+	defaults.tagsToData(deviceProfile.Tags, &data.Tags, &data.TagsAll)
 }
 
 func (r *DeviceProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -264,7 +724,7 @@ func (r *DeviceProfileResource) Create(ctx context.Context, req resource.CreateR
 	// For the purposes of this device profile code, hardcoding a response value to
 	// save into the Terraform state.
 	data.Id = types.StringValue(id)
-	deviceProfileToData(deviceProfile, &data)
+	deviceProfileToData(deviceProfile, &data, r.defaultTags)
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
@@ -292,12 +752,17 @@ func (r *DeviceProfileResource) Read(ctx context.Context, req resource.ReadReque
 	//     return
 	// }
 	deviceProfile, err := r.chirpstack.GetDeviceProfile(ctx, data.Id.ValueString())
+	// The device profile has been deleted outside of Terraform.
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read device profile, got error: %s", err))
 		return
 	}
 
-	deviceProfileToData(deviceProfile, &data)
+	deviceProfileToData(deviceProfile, &data, r.defaultTags)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -321,12 +786,25 @@ func (r *DeviceProfileResource) Update(ctx context.Context, req resource.UpdateR
 	//     return
 	// }
 	deviceProfile := deviceProfileFromData(&data)
+	if data.IgnoreAutoDetectedMeasurements.ValueBool() {
+		var prior types.Map
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("measurements"), &prior)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		existing, err := r.chirpstack.GetDeviceProfile(ctx, data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read device profile, got error: %s", err))
+			return
+		}
+		keepUnmanagedMeasurements(deviceProfile, existing, prior)
+	}
 	err := r.chirpstack.UpdateDeviceProfile(ctx, deviceProfile)
 	if err != nil {
 		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to update device profile, got error: %s", err))
 		return
 	}
-	deviceProfileToData(deviceProfile, &data)
+	deviceProfileToData(deviceProfile, &data, r.defaultTags)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
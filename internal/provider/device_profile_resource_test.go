@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -21,6 +22,12 @@ func TestAccDeviceProfileResource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("chirpstack_device_profile.test", "id"),
 					resource.TestCheckResourceAttr("chirpstack_device_profile.test", "name", "deviceprofile-one"),
+					resource.TestCheckResourceAttr("chirpstack_device_profile.test", "tags.vendor", "acme"),
+					resource.TestCheckResourceAttr("chirpstack_device_profile.test", "payload_codec_runtime", "JS"),
+					resource.TestCheckResourceAttrSet("chirpstack_device_profile.test", "payload_codec_script"),
+					resource.TestCheckResourceAttr("chirpstack_device_profile.test", "auto_detect_measurements", "true"),
+					resource.TestCheckResourceAttr("chirpstack_device_profile.test", "measurements.temperature.name", "Temperature"),
+					resource.TestCheckResourceAttr("chirpstack_device_profile.test", "measurements.temperature.kind", "GAUGE"),
 				),
 			},
 			// ImportState testing
@@ -50,6 +57,9 @@ resource "chirpstack_device_profile" "test" {
   tenant_id                       = chirpstack_tenant.test.id
   name                            = %[1]q
   description                     = "test"
+  tags = {
+    vendor = "acme"
+  }
   region                          = "AU915"
   region_parameters_revision      = "A"
   mac_version                     = "LORAWAN_1_0_3"
@@ -60,6 +70,132 @@ resource "chirpstack_device_profile" "test" {
   device_supports_otaa            = true
   device_supports_class_b         = false
   device_supports_class_c         = false
+  payload_codec_runtime           = "JS"
+  payload_codec_script            = <<-EOT
+    function decodeUplink(input) {
+      return { data: { temperature: input.bytes[0] } };
+    }
+  EOT
+  auto_detect_measurements        = true
+  measurements = {
+    temperature = {
+      name = "Temperature"
+      kind = "GAUGE"
+    }
+  }
 }
 `, deviceprofileName)
 }
+
+func TestAccDeviceProfileResource_classBAbp(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeviceProfileResourceClassBAbpConfig(true, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("chirpstack_device_profile.test", "class_b_timeout", "30"),
+					resource.TestCheckResourceAttr("chirpstack_device_profile.test", "class_b_ping_slot_nb_k", "3"),
+					resource.TestCheckResourceAttr("chirpstack_device_profile.test", "class_b_ping_slot_dr", "8"),
+					resource.TestCheckResourceAttr("chirpstack_device_profile.test", "class_b_ping_slot_freq", "923300000"),
+					resource.TestCheckResourceAttr("chirpstack_device_profile.test", "abp_rx1_delay", "1"),
+					resource.TestCheckResourceAttr("chirpstack_device_profile.test", "abp_rx1_dr_offset", "0"),
+					resource.TestCheckResourceAttr("chirpstack_device_profile.test", "abp_rx2_dr", "8"),
+					resource.TestCheckResourceAttr("chirpstack_device_profile.test", "abp_rx2_freq", "923300000"),
+				),
+			},
+			{
+				Config:      testAccDeviceProfileResourceClassBAbpConfig(false, false),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccDeviceProfileResourceClassBAbpConfig(true, true),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccDeviceProfileResourceClassBAbpConfig(classB bool, otaa bool) string {
+	return fmt.Sprintf(`
+resource "chirpstack_tenant" "test" {
+  name = "test_tenant"
+}
+resource "chirpstack_device_profile" "test" {
+  tenant_id                  = chirpstack_tenant.test.id
+  name                       = "class-b-abp"
+  region                     = "AU915"
+  region_parameters_revision = "A"
+  mac_version                = "LORAWAN_1_0_3"
+  device_supports_otaa       = %[2]t
+  device_supports_class_b    = %[1]t
+  class_b_timeout            = 30
+  class_b_ping_slot_nb_k     = 3
+  class_b_ping_slot_dr       = 8
+  class_b_ping_slot_freq     = 923300000
+  abp_rx1_delay              = 1
+  abp_rx1_dr_offset          = 0
+  abp_rx2_dr                 = 8
+  abp_rx2_freq               = 923300000
+}
+`, classB, otaa)
+}
+
+func TestDeviceProfileResource_invalidEnums(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDeviceProfileResourceEnumsConfig("AU951", "LORAWAN_1_0_3", "A"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Did you mean "AU915"\?`),
+			},
+			{
+				Config:      testAccDeviceProfileResourceEnumsConfig("AU915", "lorawan_1_0_3", "A"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Did you mean "LORAWAN_1_0_3"\?`),
+			},
+			{
+				Config:      testAccDeviceProfileResourceEnumsConfig("AU915", "LORAWAN_1_0_4", "A"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`not valid for MAC version LORAWAN_1_0_4`),
+			},
+		},
+	})
+}
+
+func testAccDeviceProfileResourceEnumsConfig(region, macVersion, revision string) string {
+	return fmt.Sprintf(`
+resource "chirpstack_device_profile" "test" {
+  tenant_id                  = "00000000-0000-0000-0000-000000000000"
+  name                       = "invalid"
+  region                     = %[1]q
+  mac_version                = %[2]q
+  region_parameters_revision = %[3]q
+}
+`, region, macVersion, revision)
+}
+
+func TestAccDeviceProfileResource_regionNotEnabled(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "chirpstack_device_profile" "test" {
+  tenant_id                  = "00000000-0000-0000-0000-000000000000"
+  name                       = "invalid"
+  region                     = "AU915"
+  region_config_id           = "au915_not_configured"
+  mac_version                = "LORAWAN_1_0_3"
+  region_parameters_revision = "A"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`is not enabled on the Chirpstack server`),
+			},
+		},
+	})
+}
@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/halter-corp/terraform-provider-chirpstack/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DeviceProfilesDataSource{}
+
+func NewDeviceProfilesDataSource() datasource.DataSource {
+	return &DeviceProfilesDataSource{}
+}
+
+// DeviceProfilesDataSource defines the data source implementation.
+type DeviceProfilesDataSource struct {
+	chirpstack client.Chirpstack
+}
+
+// DeviceProfilesDataSourceModel describes the data source data model.
+type DeviceProfilesDataSourceModel struct {
+	TenantId       types.String                        `tfsdk:"tenant_id"`
+	Search         types.String                        `tfsdk:"search"`
+	Tags           types.Map                           `tfsdk:"tags"`
+	DeviceProfiles []DeviceProfilesDataSourceItemModel `tfsdk:"device_profiles"`
+}
+
+// DeviceProfilesDataSourceItemModel describes a single device profile of the data source.
+type DeviceProfilesDataSourceItemModel struct {
+	Id                       types.String `tfsdk:"id"`
+	Name                     types.String `tfsdk:"name"`
+	Region                   types.String `tfsdk:"region"`
+	MacVersion               types.String `tfsdk:"mac_version"`
+	RegionParametersRevision types.String `tfsdk:"region_parameters_revision"`
+	DeviceSupportsOTAA       types.Bool   `tfsdk:"device_supports_otaa"`
+	DeviceSupportsClassB     types.Bool   `tfsdk:"device_supports_class_b"`
+	DeviceSupportsClassC     types.Bool   `tfsdk:"device_supports_class_c"`
+}
+
+func (d *DeviceProfilesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_profiles"
+}
+
+func (d *DeviceProfilesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DeviceProfiles data source. Lists all device profiles of a tenant.",
+
+		Attributes: map[string]schema.Attribute{
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "Tenant ID",
+				Required:            true,
+			},
+			"search": schema.StringAttribute{
+				MarkdownDescription: "Only return device profiles matching this search string.",
+				Optional:            true,
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only return device profiles that have all of these tags. Filtering on tags requires reading every device profile returned by the search.",
+				Optional:            true,
+			},
+			"device_profiles": schema.ListNestedAttribute{
+				MarkdownDescription: "Device profiles",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "DeviceProfile identifier",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Device profile name",
+							Computed:            true,
+						},
+						"region": schema.StringAttribute{
+							MarkdownDescription: "Device profile region",
+							Computed:            true,
+						},
+						"mac_version": schema.StringAttribute{
+							MarkdownDescription: "The LoRaWAN MAC version supported by the device.",
+							Computed:            true,
+						},
+						"region_parameters_revision": schema.StringAttribute{
+							MarkdownDescription: "Revision of the Regional Parameters specification supported by the device.",
+							Computed:            true,
+						},
+						"device_supports_otaa": schema.BoolAttribute{
+							MarkdownDescription: "Device supports OTAA",
+							Computed:            true,
+						},
+						"device_supports_class_b": schema.BoolAttribute{
+							MarkdownDescription: "Device supports Class-B",
+							Computed:            true,
+						},
+						"device_supports_class_c": schema.BoolAttribute{
+							MarkdownDescription: "Device supports Class-C",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DeviceProfilesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	chirpstack, ok := req.ProviderData.(client.Chirpstack)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Chirpstack, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.chirpstack = chirpstack
+}
+
+func (d *DeviceProfilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DeviceProfilesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags := stringMapFromData(data.Tags)
+	data.DeviceProfiles = []DeviceProfilesDataSourceItemModel{}
+	for deviceProfile, err := range d.chirpstack.IterateDeviceProfiles(ctx, data.TenantId.ValueString(), data.Search.ValueString()) {
+		if err != nil {
+			resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to list device profiles, got error: %s", err))
+			return
+		}
+		// Tags are not part of the list response, so they can only be
+		// filtered on by reading the device profile.
+		if len(tags) != 0 {
+			fullDeviceProfile, err := d.chirpstack.GetDeviceProfile(ctx, deviceProfile.Id)
+			if err != nil {
+				resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read device profile, got error: %s", err))
+				return
+			}
+			if !matchesTags(fullDeviceProfile.Tags, tags) {
+				continue
+			}
+		}
+		data.DeviceProfiles = append(data.DeviceProfiles, DeviceProfilesDataSourceItemModel{
+			Id:                       types.StringValue(deviceProfile.Id),
+			Name:                     types.StringValue(deviceProfile.Name),
+			Region:                   types.StringValue(deviceProfile.Region.String()),
+			MacVersion:               types.StringValue(deviceProfile.MacVersion.String()),
+			RegionParametersRevision: types.StringValue(deviceProfile.RegParamsRevision.String()),
+			DeviceSupportsOTAA:       types.BoolValue(deviceProfile.SupportsOtaa),
+			DeviceSupportsClassB:     types.BoolValue(deviceProfile.SupportsClassB),
+			DeviceSupportsClassC:     types.BoolValue(deviceProfile.SupportsClassC),
+		})
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source", map[string]interface{}{"count": len(data.DeviceProfiles)})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeviceProfilesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccDeviceProfilesDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.chirpstack_device_profiles.test", "device_profiles.#", "1"),
+					resource.TestCheckResourceAttrPair("data.chirpstack_device_profiles.test", "device_profiles.0.id", "chirpstack_device_profile.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeviceProfilesDataSourceConfig() string {
+	return testAccDeviceProfileResourceConfig("datasource-device-profiles") + `
+data "chirpstack_device_profiles" "test" {
+  tenant_id = chirpstack_device_profile.test.tenant_id
+}
+`
+}
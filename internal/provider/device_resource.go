@@ -0,0 +1,316 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+	"github.com/halter-corp/terraform-provider-chirpstack/client"
+	"github.com/halter-corp/terraform-provider-chirpstack/client/model"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DeviceResource{}
+var _ resource.ResourceWithModifyPlan = &DeviceResource{}
+var _ resource.ResourceWithImportState = &DeviceResource{}
+
+func NewDeviceResource() resource.Resource {
+	return &DeviceResource{}
+}
+
+// DeviceResource defines the resource implementation.
+type DeviceResource struct {
+	chirpstack  client.Chirpstack
+	defaultTags defaultTags
+}
+
+// DeviceModel describes the attributes shared by the device resource and data
+// source.
+type DeviceModel struct {
+	Id              types.String `tfsdk:"id"`
+	DevEui          types.String `tfsdk:"dev_eui"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	ApplicationId   types.String `tfsdk:"application_id"`
+	DeviceProfileId types.String `tfsdk:"device_profile_id"`
+	JoinEui         types.String `tfsdk:"join_eui"`
+	SkipFcntCheck   types.Bool   `tfsdk:"skip_fcnt_check"`
+	IsDisabled      types.Bool   `tfsdk:"is_disabled"`
+	Tags            types.Map    `tfsdk:"tags"`
+	Variables       types.Map    `tfsdk:"variables"`
+}
+
+// DeviceResourceModel describes the resource data model.
+type DeviceResourceModel struct {
+	DeviceModel
+	TagsAll types.Map `tfsdk:"tags_all"`
+}
+
+func (r *DeviceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device"
+}
+
+func (r *DeviceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Device resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Device identifier. This is the same as the DevEUI.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dev_eui": schema.StringAttribute{
+				MarkdownDescription: "DevEUI (EUI64)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Device name",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Device description",
+				Optional:            true,
+			},
+			"application_id": schema.StringAttribute{
+				MarkdownDescription: "Application ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"device_profile_id": schema.StringAttribute{
+				MarkdownDescription: "Device profile ID",
+				Required:            true,
+			},
+			"join_eui": schema.StringAttribute{
+				MarkdownDescription: "JoinEUI (optional, EUI64). This field will be automatically set / updated on OTAA.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"skip_fcnt_check": schema.BoolAttribute{
+				MarkdownDescription: "Skip frame-counter checks (this is insecure, but could be helpful for debugging).",
+				Optional:            true,
+				Computed:            true,
+			},
+			"is_disabled": schema.BoolAttribute{
+				MarkdownDescription: "Device is disabled.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags (user defined). These tags are exposed in the event payloads or to integration. Tags are intended for aggregation and filtering.",
+				Optional:            true,
+			},
+			"tags_all": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "All tags of the resource, including those inherited from the provider `default_tags`.",
+				Computed:            true,
+			},
+			"variables": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Variables (user defined). These variables can be used together with integrations to store tokens / secrets that must be configured per device. These variables are not exposed in the event payloads.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *DeviceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	chirpstack, ok := req.ProviderData.(client.Chirpstack)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Chirpstack, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.chirpstack = chirpstack
+	r.defaultTags = defaultTagsFromProviderData(req.ProviderData)
+}
+
+func (r *DeviceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.modifyPlan(ctx, req, resp)
+}
+
+func deviceFromData(data *DeviceResourceModel) *api.Device {
+	device := &api.Device{
+		DevEui:          data.DevEui.ValueString(),
+		Name:            data.Name.ValueString(),
+		ApplicationId:   data.ApplicationId.ValueString(),
+		DeviceProfileId: data.DeviceProfileId.ValueString(),
+		Tags:            stringMapFromData(data.TagsAll),
+		Variables:       stringMapFromData(data.Variables),
+	}
+
+	if !data.Description.IsNull() {
+		device.Description = data.Description.ValueString()
+	}
+	if !data.JoinEui.IsNull() && !data.JoinEui.IsUnknown() {
+		device.JoinEui = data.JoinEui.ValueString()
+	}
+	if !data.SkipFcntCheck.IsNull() && !data.SkipFcntCheck.IsUnknown() {
+		device.SkipFcntCheck = data.SkipFcntCheck.ValueBool()
+	}
+	if !data.IsDisabled.IsNull() && !data.IsDisabled.IsUnknown() {
+		device.IsDisabled = data.IsDisabled.ValueBool()
+	}
+
+	return device
+}
+
+// deviceAttributesToData sets the attributes shared by the device resource and
+// data source, except for the tags.
+func deviceAttributesToData(device *model.GetDeviceResponse, data *DeviceModel) {
+	data.Id = types.StringValue(device.Device.DevEui)
+	data.DevEui = types.StringValue(device.Device.DevEui)
+	data.Name = types.StringValue(device.Device.Name)
+	if device.Device.Description != "" {
+		data.Description = types.StringValue(device.Device.Description)
+	}
+	data.ApplicationId = types.StringValue(device.Device.ApplicationId)
+	data.DeviceProfileId = types.StringValue(device.Device.DeviceProfileId)
+	data.JoinEui = types.StringValue(device.Device.JoinEui)
+	data.SkipFcntCheck = types.BoolValue(device.Device.SkipFcntCheck)
+	data.IsDisabled = types.BoolValue(device.Device.IsDisabled)
+	data.Variables = stringMapToData(device.Device.Variables, data.Variables)
+}
+
+func deviceToData(device *model.GetDeviceResponse, data *DeviceResourceModel, defaults defaultTags) {
+	deviceAttributesToData(device, &data.DeviceModel)
+	defaults.tagsToData(device.Device.Tags, &data.Tags, &data.TagsAll)
+}
+
+func (r *DeviceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DeviceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.chirpstack.CreateDevice(ctx, deviceFromData(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to create device, got error: %s", err))
+		return
+	}
+
+	// Read the device back so that server side defaults (e.g. join_eui) end up in the state.
+	device, err := r.chirpstack.GetDevice(ctx, data.DevEui.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read device, got error: %s", err))
+		return
+	}
+	deviceToData(device, &data, r.defaultTags)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeviceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DeviceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	device, err := r.chirpstack.GetDevice(ctx, data.Id.ValueString())
+	// The device has been deleted outside of Terraform.
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read device, got error: %s", err))
+		return
+	}
+
+	deviceToData(device, &data, r.defaultTags)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeviceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DeviceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.chirpstack.UpdateDevice(ctx, deviceFromData(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to update device, got error: %s", err))
+		return
+	}
+
+	device, err := r.chirpstack.GetDevice(ctx, data.DevEui.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read device, got error: %s", err))
+		return
+	}
+	deviceToData(device, &data, r.defaultTags)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeviceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DeviceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.chirpstack.DeleteDevice(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to delete device, got error: %s", err))
+		return
+	}
+}
+
+func (r *DeviceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeviceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDeviceResourceConfig("device-one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("chirpstack_device.test", "id", "0102030405060708"),
+					resource.TestCheckResourceAttr("chirpstack_device.test", "name", "device-one"),
+					resource.TestCheckResourceAttr("chirpstack_device.test", "tags.site", "test"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "chirpstack_device.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccDeviceResourceConfig("two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("chirpstack_device.test", "name", "two"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDeviceResourceConfig(deviceName string) string {
+	return fmt.Sprintf(`
+resource "chirpstack_tenant" "test" {
+  name = "test_tenant"
+}
+resource "chirpstack_application" "test" {
+  tenant_id = chirpstack_tenant.test.id
+  name      = "test_app"
+}
+resource "chirpstack_device_profile" "test" {
+  tenant_id                  = chirpstack_tenant.test.id
+  name                       = "test_device_profile"
+  region                     = "AU915"
+  region_parameters_revision = "A"
+  mac_version                = "LORAWAN_1_0_3"
+  device_supports_otaa       = true
+}
+resource "chirpstack_device" "test" {
+  dev_eui           = "0102030405060708"
+  name              = %[1]q
+  application_id    = chirpstack_application.test.id
+  device_profile_id = chirpstack_device_profile.test.id
+  tags = {
+    site = "test"
+  }
+}
+`, deviceName)
+}
@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/halter-corp/terraform-provider-chirpstack/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DevicesDataSource{}
+
+func NewDevicesDataSource() datasource.DataSource {
+	return &DevicesDataSource{}
+}
+
+// DevicesDataSource defines the data source implementation.
+type DevicesDataSource struct {
+	chirpstack client.Chirpstack
+}
+
+// DevicesDataSourceModel describes the data source data model.
+type DevicesDataSourceModel struct {
+	ApplicationId types.String                 `tfsdk:"application_id"`
+	Search        types.String                 `tfsdk:"search"`
+	Tags          types.Map                    `tfsdk:"tags"`
+	Devices       []DevicesDataSourceItemModel `tfsdk:"devices"`
+}
+
+// DevicesDataSourceItemModel describes a single device of the data source.
+type DevicesDataSourceItemModel struct {
+	DevEui            types.String `tfsdk:"dev_eui"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	DeviceProfileId   types.String `tfsdk:"device_profile_id"`
+	DeviceProfileName types.String `tfsdk:"device_profile_name"`
+}
+
+func (d *DevicesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_devices"
+}
+
+func (d *DevicesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Devices data source. Lists all devices of an application.",
+
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				MarkdownDescription: "Application ID",
+				Required:            true,
+			},
+			"search": schema.StringAttribute{
+				MarkdownDescription: "Only return devices matching this search string (name or DevEUI).",
+				Optional:            true,
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only return devices that have all of these tags. Filtering on tags requires reading every device returned by the search.",
+				Optional:            true,
+			},
+			"devices": schema.ListNestedAttribute{
+				MarkdownDescription: "Devices",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"dev_eui": schema.StringAttribute{
+							MarkdownDescription: "DevEUI (EUI64)",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Device name",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Device description",
+							Computed:            true,
+						},
+						"device_profile_id": schema.StringAttribute{
+							MarkdownDescription: "Device profile ID",
+							Computed:            true,
+						},
+						"device_profile_name": schema.StringAttribute{
+							MarkdownDescription: "Device profile name",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DevicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	chirpstack, ok := req.ProviderData.(client.Chirpstack)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Chirpstack, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.chirpstack = chirpstack
+}
+
+func (d *DevicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DevicesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags := stringMapFromData(data.Tags)
+	data.Devices = []DevicesDataSourceItemModel{}
+	for device, err := range d.chirpstack.IterateDevices(ctx, data.ApplicationId.ValueString(), data.Search.ValueString()) {
+		if err != nil {
+			resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to list devices, got error: %s", err))
+			return
+		}
+		// Tags are not part of the list response, so they can only be
+		// filtered on by reading the device.
+		if len(tags) != 0 {
+			fullDevice, err := d.chirpstack.GetDeviceInfo(ctx, device.DevEui)
+			if err != nil {
+				resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read device, got error: %s", err))
+				return
+			}
+			if !matchesTags(fullDevice.Tags, tags) {
+				continue
+			}
+		}
+		data.Devices = append(data.Devices, DevicesDataSourceItemModel{
+			DevEui:            types.StringValue(device.DevEui),
+			Name:              types.StringValue(device.Name),
+			Description:       types.StringValue(device.Description),
+			DeviceProfileId:   types.StringValue(device.DeviceProfileId),
+			DeviceProfileName: types.StringValue(device.DeviceProfileName),
+		})
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source", map[string]interface{}{"count": len(data.Devices)})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
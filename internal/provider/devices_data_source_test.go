@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDevicesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccDevicesDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.chirpstack_devices.all", "devices.#", "1"),
+					resource.TestCheckResourceAttrPair("data.chirpstack_devices.all", "devices.0.dev_eui", "chirpstack_device.test", "dev_eui"),
+					resource.TestCheckResourceAttr("data.chirpstack_devices.tagged", "devices.#", "1"),
+					resource.TestCheckResourceAttr("data.chirpstack_devices.other_tag", "devices.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDevicesDataSourceConfig() string {
+	return testAccDeviceResourceConfig("datasource-devices") + `
+data "chirpstack_devices" "all" {
+  application_id = chirpstack_device.test.application_id
+}
+data "chirpstack_devices" "tagged" {
+  application_id = chirpstack_device.test.application_id
+  tags = {
+    site = "test"
+  }
+}
+data "chirpstack_devices" "other_tag" {
+  application_id = chirpstack_device.test.application_id
+  tags = {
+    site = "other"
+  }
+}
+`
+}
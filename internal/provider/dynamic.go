@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// goToValue converts a JSON like Go value, such as a value exported from
+// JavaScript, into a Terraform value. Objects become Terraform objects and
+// arrays become tuples, so that their elements can have different types.
+func goToValue(v any) (attr.Value, error) {
+	switch v := v.(type) {
+	case nil:
+		return types.StringNull(), nil
+	case bool:
+		return types.BoolValue(v), nil
+	case string:
+		return types.StringValue(v), nil
+	case int64:
+		return types.NumberValue(new(big.Float).SetInt64(v)), nil
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, fmt.Errorf("unsupported number: %v", v)
+		}
+		return types.NumberValue(big.NewFloat(v)), nil
+	case time.Time:
+		return types.StringValue(v.UTC().Format(time.RFC3339Nano)), nil
+	case []byte:
+		items := make([]any, 0, len(v))
+		for _, b := range v {
+			items = append(items, int64(b))
+		}
+		return goToValue(items)
+	case []any:
+		elementTypes := []attr.Type{}
+		elements := []attr.Value{}
+		for _, item := range v {
+			element, err := goToValue(item)
+			if err != nil {
+				return nil, err
+			}
+			elementTypes = append(elementTypes, element.Type(context.Background()))
+			elements = append(elements, element)
+		}
+		value, diags := types.TupleValue(elementTypes, elements)
+		if diags.HasError() {
+			return nil, fmt.Errorf("unable to convert array: %v", diags)
+		}
+		return value, nil
+	case map[string]any:
+		attributeTypes := map[string]attr.Type{}
+		attributes := map[string]attr.Value{}
+		for key, item := range v {
+			attribute, err := goToValue(item)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+			attributeTypes[key] = attribute.Type(context.Background())
+			attributes[key] = attribute
+		}
+		value, diags := types.ObjectValue(attributeTypes, attributes)
+		if diags.HasError() {
+			return nil, fmt.Errorf("unable to convert object: %v", diags)
+		}
+		return value, nil
+	default:
+		return nil, fmt.Errorf("unsupported value of type %T", v)
+	}
+}
+
+// valueToGo converts a known Terraform value into a JSON like Go value. Maps
+// and objects become map[string]any, lists, sets and tuples become []any and
+// numbers become int64 or float64.
+func valueToGo(ctx context.Context, v attr.Value) (any, error) {
+	if dynamic, ok := v.(types.Dynamic); ok {
+		if dynamic.IsUnderlyingValueNull() || dynamic.IsUnderlyingValueUnknown() {
+			return nil, nil
+		}
+		v = dynamic.UnderlyingValue()
+	}
+	value, err := v.ToTerraformValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tftypesToGo(value)
+}
+
+func tftypesToGo(value tftypes.Value) (any, error) {
+	if !value.IsKnown() {
+		return nil, fmt.Errorf("value is not known")
+	}
+	if value.IsNull() {
+		return nil, nil
+	}
+
+	switch typ := value.Type(); {
+	case typ.Is(tftypes.String):
+		var s string
+		err := value.As(&s)
+		return s, err
+	case typ.Is(tftypes.Bool):
+		var b bool
+		err := value.As(&b)
+		return b, err
+	case typ.Is(tftypes.Number):
+		n := new(big.Float)
+		if err := value.As(&n); err != nil {
+			return nil, err
+		}
+		if n.IsInt() {
+			if i, accuracy := n.Int64(); accuracy == big.Exact {
+				return i, nil
+			}
+		}
+		f, _ := n.Float64()
+		return f, nil
+	case typ.Is(tftypes.Object{}), typ.Is(tftypes.Map{}):
+		var attributes map[string]tftypes.Value
+		if err := value.As(&attributes); err != nil {
+			return nil, err
+		}
+		result := map[string]any{}
+		for key, attribute := range attributes {
+			converted, err := tftypesToGo(attribute)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+			result[key] = converted
+		}
+		return result, nil
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+		result := []any{}
+		for _, element := range elements {
+			converted, err := tftypesToGo(element)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, converted)
+		}
+		return result, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %s", typ)
+	}
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = EncodeDownlinkFunction{}
+)
+
+func NewEncodeDownlinkFunction() function.Function {
+	return EncodeDownlinkFunction{}
+}
+
+// EncodeDownlinkFunction runs the encodeDownlink function of a payload codec.
+type EncodeDownlinkFunction struct{}
+
+func (r EncodeDownlinkFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "encode_downlink"
+}
+
+func (r EncodeDownlinkFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Encode a downlink with a JavaScript payload codec",
+		MarkdownDescription: "Runs the `encodeDownlink` function of a Chirpstack JavaScript payload codec, as used by `payload_codec_script` of `chirpstack_device_profile`, " +
+			"so that codecs can be tested before they are applied. " +
+			"The codec runs in an embedded JavaScript engine without access to the file system or network and must finish within " + codecTimeout.String() + ".\n\n" +
+			"Returns an object with the hex encoded `bytes_hex`, the `fport` (null unless set by the codec), and the `warnings` and `errors` returned by the codec.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "script",
+				MarkdownDescription: "JavaScript payload codec",
+			},
+			function.DynamicParameter{
+				Name:                "object",
+				MarkdownDescription: "Object to encode, passed as `input.data`",
+			},
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+func (r EncodeDownlinkFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var script string
+	var object types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &script, &object))
+
+	if resp.Error != nil {
+		return
+	}
+
+	data, err := valueToGo(ctx, object)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Unable to convert object: %s", err))
+		return
+	}
+
+	result, err := runCodec(ctx, script, "encodeDownlink", map[string]any{
+		"data":      data,
+		"variables": map[string]string{},
+	})
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Unable to encode downlink: %s", err))
+		return
+	}
+
+	payload, err := codecBytes(result["bytes"])
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Unable to encode downlink: %s", err))
+		return
+	}
+	fPort := types.Int64Null()
+	if value, ok := result["fPort"].(int64); ok {
+		fPort = types.Int64Value(value)
+	}
+
+	output, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"bytes_hex": types.StringType,
+			"fport":     types.Int64Type,
+			"warnings":  types.ListType{ElemType: types.StringType},
+			"errors":    types.ListType{ElemType: types.StringType},
+		},
+		map[string]attr.Value{
+			"bytes_hex": types.StringValue(hex.EncodeToString(payload)),
+			"fport":     fPort,
+			"warnings":  stringListValue(codecMessages(result, "warnings")),
+			"errors":    stringListValue(codecMessages(result, "errors")),
+		},
+	)
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, types.DynamicValue(output)))
+}
+
+// codecBytes converts the bytes returned by encodeDownlink into a byte slice.
+func codecBytes(v any) ([]byte, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		return v, nil
+	case []any:
+		payload := make([]byte, 0, len(v))
+		for i, item := range v {
+			var b float64
+			switch item := item.(type) {
+			case int64:
+				b = float64(item)
+			case float64:
+				b = item
+			default:
+				return nil, fmt.Errorf("bytes[%d] is not a number: %v", i, item)
+			}
+			if b < 0 || b > math.MaxUint8 || b != math.Trunc(b) {
+				return nil, fmt.Errorf("bytes[%d] is not a byte: %v", i, item)
+			}
+			payload = append(payload, byte(b))
+		}
+		return payload, nil
+	default:
+		return nil, fmt.Errorf("bytes must be an array, got: %T", v)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestEncodeDownlinkFunction_Known(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					encoded = provider::chirpstack::encode_downlink(<<-EOT
+						function encodeDownlink(input) {
+							return {
+								bytes: [input.data.interval >> 8, input.data.interval & 0xff],
+								fPort: 2,
+							};
+						}
+					EOT
+					, { interval = 600 })
+				}
+
+				output "bytes_hex" {
+					value = local.encoded.bytes_hex
+				}
+				output "fport" {
+					value = local.encoded.fport
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("bytes_hex", "0258"),
+					resource.TestCheckOutput("fport", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestEncodeDownlinkFunction_InvalidBytes(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::chirpstack::encode_downlink("function encodeDownlink(input) { return { bytes: [256] }; }", {})
+				}
+				`,
+				ExpectError: regexp.MustCompile(`is not a byte`),
+			},
+		},
+	})
+}
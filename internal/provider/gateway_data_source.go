@@ -0,0 +1,175 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/halter-corp/terraform-provider-chirpstack/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GatewayDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &GatewayDataSource{}
+
+func NewGatewayDataSource() datasource.DataSource {
+	return &GatewayDataSource{}
+}
+
+// GatewayDataSource defines the data source implementation.
+type GatewayDataSource struct {
+	chirpstack client.Chirpstack
+}
+
+func (d *GatewayDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gateway"
+}
+
+func (d *GatewayDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Gateway data source. Looks up a gateway by gateway ID, or by exact name (optionally within a tenant).",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Gateway identifier. This is the same as the gateway ID.",
+				Computed:            true,
+			},
+			"gateway_id": schema.StringAttribute{
+				MarkdownDescription: "Gateway ID (EUI64)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "Tenant ID. When looking up the gateway by name, this limits the search to the given tenant.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Gateway name",
+				Optional:            true,
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Gateway description",
+				Computed:            true,
+			},
+			"location": schema.SingleNestedAttribute{
+				MarkdownDescription: "Gateway location",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"latitude": schema.Float64Attribute{
+						MarkdownDescription: "Latitude",
+						Computed:            true,
+					},
+					"longitude": schema.Float64Attribute{
+						MarkdownDescription: "Longitude",
+						Computed:            true,
+					},
+					"altitude": schema.Float64Attribute{
+						MarkdownDescription: "Altitude (meters)",
+						Computed:            true,
+					},
+					"accuracy": schema.Float64Attribute{
+						MarkdownDescription: "Accuracy (meters)",
+						Computed:            true,
+					},
+				},
+			},
+			"stats_interval": schema.Int64Attribute{
+				MarkdownDescription: "Stats interval (seconds). This defines the expected interval in which the gateway sends its statistics.",
+				Computed:            true,
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags (user defined)",
+				Computed:            true,
+			},
+			"metadata": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Metadata",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GatewayDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("gateway_id"), path.MatchRoot("name")),
+	}
+}
+
+func (d *GatewayDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	chirpstack, ok := req.ProviderData.(client.Chirpstack)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Chirpstack, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.chirpstack = chirpstack
+}
+
+func (d *GatewayDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GatewayModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gatewayId := data.GatewayId.ValueString()
+	if data.GatewayId.IsNull() {
+		name := data.Name.ValueString()
+		var matches []string
+		for gateway, err := range d.chirpstack.IterateGateways(ctx, data.TenantId.ValueString(), name) {
+			if err != nil {
+				resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to list gateways, got error: %s", err))
+				return
+			}
+			if gateway.Name == name {
+				matches = append(matches, gateway.GatewayId)
+			}
+		}
+		if len(matches) != 1 {
+			resp.Diagnostics.AddError("Gateway Not Found", fmt.Sprintf("Expected exactly one gateway with name %q, found %d", name, len(matches)))
+			return
+		}
+		gatewayId = matches[0]
+	}
+
+	gateway, err := d.chirpstack.GetGateway(ctx, gatewayId)
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read gateway, got error: %s", err))
+		return
+	}
+
+	gatewayAttributesToData(gateway, &data)
+	data.Tags = stringMapToData(gateway.Tags, data.Tags)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGatewayDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGatewayDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.chirpstack_gateway.by_gateway_id", "gateway_id", "chirpstack_gateway.test", "gateway_id"),
+					resource.TestCheckResourceAttrPair("data.chirpstack_gateway.by_name", "gateway_id", "chirpstack_gateway.test", "gateway_id"),
+					resource.TestCheckResourceAttr("data.chirpstack_gateway.by_gateway_id", "name", "datasource-gateway"),
+					resource.TestCheckResourceAttr("data.chirpstack_gateway.by_name", "name", "datasource-gateway"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGatewayDataSourceConfig() string {
+	return testAccGatewayResourceConfig("datasource-gateway") + `
+data "chirpstack_gateway" "by_gateway_id" {
+  gateway_id = chirpstack_gateway.test.gateway_id
+}
+data "chirpstack_gateway" "by_name" {
+  name      = chirpstack_gateway.test.name
+  tenant_id = chirpstack_tenant.test.id
+}
+`
+}
@@ -0,0 +1,349 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+	"github.com/chirpstack/chirpstack/api/go/v4/common"
+	"github.com/halter-corp/terraform-provider-chirpstack/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GatewayResource{}
+var _ resource.ResourceWithModifyPlan = &GatewayResource{}
+var _ resource.ResourceWithImportState = &GatewayResource{}
+
+func NewGatewayResource() resource.Resource {
+	return &GatewayResource{}
+}
+
+// GatewayResource defines the resource implementation.
+type GatewayResource struct {
+	chirpstack  client.Chirpstack
+	defaultTags defaultTags
+}
+
+// GatewayModel describes the attributes shared by the gateway resource and data
+// source.
+type GatewayModel struct {
+	Id            types.String `tfsdk:"id"`
+	GatewayId     types.String `tfsdk:"gateway_id"`
+	TenantId      types.String `tfsdk:"tenant_id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	Location      types.Object `tfsdk:"location"`
+	StatsInterval types.Int64  `tfsdk:"stats_interval"`
+	Tags          types.Map    `tfsdk:"tags"`
+	Metadata      types.Map    `tfsdk:"metadata"`
+}
+
+// GatewayResourceModel describes the resource data model.
+type GatewayResourceModel struct {
+	GatewayModel
+	TagsAll types.Map `tfsdk:"tags_all"`
+}
+
+// gatewayLocationAttrTypes are the attribute types of the location of a
+// gateway.
+var gatewayLocationAttrTypes = map[string]attr.Type{
+	"latitude":  types.Float64Type,
+	"longitude": types.Float64Type,
+	"altitude":  types.Float64Type,
+	"accuracy":  types.Float64Type,
+}
+
+func (r *GatewayResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gateway"
+}
+
+func (r *GatewayResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Gateway resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Gateway identifier. This is the same as the gateway ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"gateway_id": schema.StringAttribute{
+				MarkdownDescription: "Gateway ID (EUI64)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "Tenant ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Gateway name",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Gateway description",
+				Optional:            true,
+			},
+			"location": schema.SingleNestedAttribute{
+				MarkdownDescription: "Gateway location. If not set, the location reported by the gateway, e.g. from its GPS, is kept.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"latitude": schema.Float64Attribute{
+						MarkdownDescription: "Latitude",
+						Required:            true,
+					},
+					"longitude": schema.Float64Attribute{
+						MarkdownDescription: "Longitude",
+						Required:            true,
+					},
+					"altitude": schema.Float64Attribute{
+						MarkdownDescription: "Altitude (meters)",
+						Optional:            true,
+						Computed:            true,
+						Default:             float64default.StaticFloat64(0),
+					},
+					"accuracy": schema.Float64Attribute{
+						MarkdownDescription: "Accuracy (meters)",
+						Optional:            true,
+						Computed:            true,
+						Default:             float64default.StaticFloat64(0),
+					},
+				},
+			},
+			"stats_interval": schema.Int64Attribute{
+				MarkdownDescription: "Stats interval (seconds). This defines the expected interval in which the gateway sends its statistics.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(30),
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags (user defined)",
+				Optional:            true,
+			},
+			"tags_all": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "All tags of the resource, including those inherited from the provider `default_tags`.",
+				Computed:            true,
+			},
+			"metadata": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Metadata. Note that metadata reported by the gateway in its statistics overwrites these values.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *GatewayResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	chirpstack, ok := req.ProviderData.(client.Chirpstack)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Chirpstack, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.chirpstack = chirpstack
+	r.defaultTags = defaultTagsFromProviderData(req.ProviderData)
+}
+
+func (r *GatewayResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.modifyPlan(ctx, req, resp)
+}
+
+func gatewayFromData(data *GatewayResourceModel) *api.Gateway {
+	gateway := &api.Gateway{
+		GatewayId:     data.GatewayId.ValueString(),
+		TenantId:      data.TenantId.ValueString(),
+		Name:          data.Name.ValueString(),
+		StatsInterval: uint32(data.StatsInterval.ValueInt64()),
+		Tags:          stringMapFromData(data.TagsAll),
+		Metadata:      stringMapFromData(data.Metadata),
+		Location:      &common.Location{},
+	}
+
+	if !data.Description.IsNull() {
+		gateway.Description = data.Description.ValueString()
+	}
+	// When the location is not configured, the plan holds the prior location,
+	// so that a location reported by the gateway is not overwritten.
+	if !data.Location.IsNull() && !data.Location.IsUnknown() {
+		attributes := data.Location.Attributes()
+		gateway.Location.Latitude = attributes["latitude"].(types.Float64).ValueFloat64()
+		gateway.Location.Longitude = attributes["longitude"].(types.Float64).ValueFloat64()
+		gateway.Location.Altitude = attributes["altitude"].(types.Float64).ValueFloat64()
+		gateway.Location.Accuracy = float32(attributes["accuracy"].(types.Float64).ValueFloat64())
+	}
+
+	return gateway
+}
+
+// gatewayAttributesToData sets the attributes shared by the gateway resource
+// and data source, except for the tags.
+func gatewayAttributesToData(gateway *api.Gateway, data *GatewayModel) {
+	data.Id = types.StringValue(gateway.GatewayId)
+	data.GatewayId = types.StringValue(gateway.GatewayId)
+	data.TenantId = types.StringValue(gateway.TenantId)
+	data.Name = types.StringValue(gateway.Name)
+	if gateway.Description != "" {
+		data.Description = types.StringValue(gateway.Description)
+	}
+	location := gateway.GetLocation()
+	hasLocation := !data.Location.IsNull() && !data.Location.IsUnknown()
+	if hasLocation || location.GetLatitude() != 0 || location.GetLongitude() != 0 || location.GetAltitude() != 0 {
+		data.Location = types.ObjectValueMust(gatewayLocationAttrTypes, map[string]attr.Value{
+			"latitude":  types.Float64Value(location.GetLatitude()),
+			"longitude": types.Float64Value(location.GetLongitude()),
+			"altitude":  types.Float64Value(location.GetAltitude()),
+			"accuracy":  types.Float64Value(float32ToFloat64(location.GetAccuracy())),
+		})
+	} else {
+		data.Location = types.ObjectNull(gatewayLocationAttrTypes)
+	}
+	data.StatsInterval = types.Int64Value(int64(gateway.StatsInterval))
+	data.Metadata = stringMapToData(gateway.Metadata, data.Metadata)
+}
+
+func gatewayToData(gateway *api.Gateway, data *GatewayResourceModel, defaults defaultTags) {
+	gatewayAttributesToData(gateway, &data.GatewayModel)
+	defaults.tagsToData(gateway.Tags, &data.Tags, &data.TagsAll)
+}
+
+// float32ToFloat64 widens f using its shortest decimal representation, so
+// that e.g. 1.1 does not come back as 1.100000023841858.
+func float32ToFloat64(f float32) float64 {
+	v, _ := strconv.ParseFloat(strconv.FormatFloat(float64(f), 'g', -1, 32), 64)
+	return v
+}
+
+func (r *GatewayResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GatewayResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gateway := gatewayFromData(&data)
+	err := r.chirpstack.CreateGateway(ctx, gateway)
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to create gateway, got error: %s", err))
+		return
+	}
+	gatewayToData(gateway, &data, r.defaultTags)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GatewayResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GatewayResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gateway, err := r.chirpstack.GetGateway(ctx, data.Id.ValueString())
+	// The gateway has been deleted outside of Terraform.
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read gateway, got error: %s", err))
+		return
+	}
+
+	gatewayToData(gateway, &data, r.defaultTags)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GatewayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GatewayResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gateway := gatewayFromData(&data)
+	err := r.chirpstack.UpdateGateway(ctx, gateway)
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to update gateway, got error: %s", err))
+		return
+	}
+	gatewayToData(gateway, &data, r.defaultTags)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GatewayResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GatewayResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.chirpstack.DeleteGateway(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to delete gateway, got error: %s", err))
+		return
+	}
+}
+
+func (r *GatewayResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGatewayResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGatewayResourceConfig("gateway-one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("chirpstack_gateway.test", "id", "0102030405060708"),
+					resource.TestCheckResourceAttr("chirpstack_gateway.test", "name", "gateway-one"),
+					resource.TestCheckResourceAttr("chirpstack_gateway.test", "location.latitude", "-37.787"),
+					resource.TestCheckResourceAttr("chirpstack_gateway.test", "stats_interval", "30"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "chirpstack_gateway.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccGatewayResourceConfig("two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("chirpstack_gateway.test", "name", "two"),
+				),
+			},
+			// Removing location keeps the location of the gateway
+			{
+				Config: testAccGatewayResourceConfigWithoutLocation("two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("chirpstack_gateway.test", "location.latitude", "-37.787"),
+					resource.TestCheckResourceAttr("chirpstack_gateway.test", "location.longitude", "175.2793"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccGatewayResourceConfig(gatewayName string) string {
+	return fmt.Sprintf(`
+resource "chirpstack_tenant" "test" {
+  name              = "test_tenant"
+  can_have_gateways = true
+}
+resource "chirpstack_gateway" "test" {
+  gateway_id = "0102030405060708"
+  tenant_id  = chirpstack_tenant.test.id
+  name       = %[1]q
+  location = {
+    latitude  = -37.787
+    longitude = 175.2793
+  }
+}
+`, gatewayName)
+}
+
+func testAccGatewayResourceConfigWithoutLocation(gatewayName string) string {
+	return fmt.Sprintf(`
+resource "chirpstack_tenant" "test" {
+  name              = "test_tenant"
+  can_have_gateways = true
+}
+resource "chirpstack_gateway" "test" {
+  gateway_id = "0102030405060708"
+  tenant_id  = chirpstack_tenant.test.id
+  name       = %[1]q
+}
+`, gatewayName)
+}
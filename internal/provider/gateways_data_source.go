@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/halter-corp/terraform-provider-chirpstack/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GatewaysDataSource{}
+
+func NewGatewaysDataSource() datasource.DataSource {
+	return &GatewaysDataSource{}
+}
+
+// GatewaysDataSource defines the data source implementation.
+type GatewaysDataSource struct {
+	chirpstack client.Chirpstack
+}
+
+// GatewaysDataSourceModel describes the data source data model.
+type GatewaysDataSourceModel struct {
+	TenantId types.String                  `tfsdk:"tenant_id"`
+	Search   types.String                  `tfsdk:"search"`
+	Tags     types.Map                     `tfsdk:"tags"`
+	Gateways []GatewaysDataSourceItemModel `tfsdk:"gateways"`
+}
+
+// GatewaysDataSourceItemModel describes a single gateway of the data source.
+type GatewaysDataSourceItemModel struct {
+	GatewayId   types.String `tfsdk:"gateway_id"`
+	TenantId    types.String `tfsdk:"tenant_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	State       types.String `tfsdk:"state"`
+}
+
+func (d *GatewaysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gateways"
+}
+
+func (d *GatewaysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Gateways data source. Lists all gateways, optionally limited to a single tenant.",
+
+		Attributes: map[string]schema.Attribute{
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "Only return gateways of this tenant. Required when using a tenant API key.",
+				Optional:            true,
+			},
+			"search": schema.StringAttribute{
+				MarkdownDescription: "Only return gateways matching this search string (name or gateway ID).",
+				Optional:            true,
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only return gateways that have all of these tags. Filtering on tags requires reading every gateway returned by the search.",
+				Optional:            true,
+			},
+			"gateways": schema.ListNestedAttribute{
+				MarkdownDescription: "Gateways",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"gateway_id": schema.StringAttribute{
+							MarkdownDescription: "Gateway ID (EUI64)",
+							Computed:            true,
+						},
+						"tenant_id": schema.StringAttribute{
+							MarkdownDescription: "Tenant ID",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Gateway name",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Gateway description",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "Gateway state. NEVER_SEEN, ONLINE or OFFLINE.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GatewaysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	chirpstack, ok := req.ProviderData.(client.Chirpstack)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Chirpstack, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.chirpstack = chirpstack
+}
+
+func (d *GatewaysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GatewaysDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags := stringMapFromData(data.Tags)
+	data.Gateways = []GatewaysDataSourceItemModel{}
+	for gateway, err := range d.chirpstack.IterateGateways(ctx, data.TenantId.ValueString(), data.Search.ValueString()) {
+		if err != nil {
+			resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to list gateways, got error: %s", err))
+			return
+		}
+		// Tags are not part of the list response, so they can only be
+		// filtered on by reading the gateway.
+		if len(tags) != 0 {
+			fullGateway, err := d.chirpstack.GetGateway(ctx, gateway.GatewayId)
+			if err != nil {
+				resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read gateway, got error: %s", err))
+				return
+			}
+			if !matchesTags(fullGateway.Tags, tags) {
+				continue
+			}
+		}
+		data.Gateways = append(data.Gateways, GatewaysDataSourceItemModel{
+			GatewayId:   types.StringValue(gateway.GatewayId),
+			TenantId:    types.StringValue(gateway.TenantId),
+			Name:        types.StringValue(gateway.Name),
+			Description: types.StringValue(gateway.Description),
+			State:       types.StringValue(gateway.State.String()),
+		})
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source", map[string]interface{}{"count": len(data.Gateways)})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGatewaysDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGatewaysDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.chirpstack_gateways.test", "gateways.#", "1"),
+					resource.TestCheckResourceAttrPair("data.chirpstack_gateways.test", "gateways.0.gateway_id", "chirpstack_gateway.test", "gateway_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGatewaysDataSourceConfig() string {
+	return testAccGatewayResourceConfig("datasource-gateways") + `
+data "chirpstack_gateways" "test" {
+  tenant_id = chirpstack_gateway.test.tenant_id
+}
+`
+}
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// stringMapFromData converts a Terraform map of strings into a Go map.
+// Null and unknown maps are returned as nil.
+func stringMapFromData(m types.Map) map[string]string {
+	if m.IsNull() || m.IsUnknown() {
+		return nil
+	}
+	result := map[string]string{}
+	for k, v := range m.Elements() {
+		if s, ok := v.(types.String); ok {
+			result[k] = s.ValueString()
+		}
+	}
+	return result
+}
+
+// stringMapToData converts a Go map into a Terraform map of strings. An empty
+// map is stored as null unless the current value is an explicitly empty map,
+// so that both "unset" and "{}" in configuration stay consistent.
+func stringMapToData(m map[string]string, current types.Map) types.Map {
+	if len(m) == 0 && (current.IsNull() || current.IsUnknown()) {
+		return types.MapNull(types.StringType)
+	}
+	elements := map[string]attr.Value{}
+	for k, v := range m {
+		elements[k] = types.StringValue(v)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// splitCompositeId splits an identifier of the form "a/b" into its two parts.
+// It returns false if the identifier is not of that form.
+func splitCompositeId(id string) (string, string, bool) {
+	first, second, found := strings.Cut(id, "/")
+	if !found || first == "" || second == "" || strings.Contains(second, "/") {
+		return "", "", false
+	}
+	return first, second, true
+}
+
+// stringSetFromData converts a Terraform set of strings into a Go slice.
+// Null and unknown sets are returned as nil.
+func stringSetFromData(s types.Set) []string {
+	if s.IsNull() || s.IsUnknown() {
+		return nil
+	}
+	var result []string
+	for _, v := range s.Elements() {
+		if str, ok := v.(types.String); ok {
+			result = append(result, str.ValueString())
+		}
+	}
+	return result
+}
+
+// stringSetToData converts a Go slice into a Terraform set of strings. Like
+// stringMapToData, an empty slice is stored as null unless the current value
+// is an explicitly empty set.
+func stringSetToData(s []string, current types.Set) types.Set {
+	if len(s) == 0 && (current.IsNull() || current.IsUnknown()) {
+		return types.SetNull(types.StringType)
+	}
+	elements := []attr.Value{}
+	for _, v := range s {
+		elements = append(elements, types.StringValue(v))
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
+
+// matchesTags reports whether tags contains every key / value pair of filter.
+func matchesTags(tags, filter map[string]string) bool {
+	for k, v := range filter {
+		if value, ok := tags[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
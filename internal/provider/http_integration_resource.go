@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -64,6 +65,9 @@ func (r *HttpIntegrationResource) Schema(ctx context.Context, req resource.Schem
 			"encoding": schema.StringAttribute{
 				MarkdownDescription: "Http Integration encoding. JSON or PROTOBUF.",
 				Required:            true,
+				Validators: []validator.String{
+					enumOf(api.Encoding_name),
+				},
 			},
 			"event_endpoint_url": schema.StringAttribute{
 				MarkdownDescription: "Http Integration URL",
@@ -183,6 +187,11 @@ func (r *HttpIntegrationResource) Read(ctx context.Context, req resource.ReadReq
 	//     return
 	// }
 	httpIntegration, err := r.chirpstack.GetHttpIntegration(ctx, data.Id.ValueString())
+	// The HTTP integration has been deleted outside of Terraform.
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read httpintegration, got error: %s", err))
 		return
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// measurementAttrTypes are the attribute types of a device profile
+// measurement.
+var measurementAttrTypes = map[string]attr.Type{
+	"name": types.StringType,
+	"kind": types.StringType,
+}
+
+// measurementsFromData converts the measurements map of a device profile to
+// the Chirpstack measurements.
+func measurementsFromData(measurements types.Map) map[string]*api.Measurement {
+	result := map[string]*api.Measurement{}
+	for key, value := range measurements.Elements() {
+		attributes := value.(types.Object).Attributes()
+		result[key] = &api.Measurement{
+			Name: attributes["name"].(types.String).ValueString(),
+			Kind: api.MeasurementKind(api.MeasurementKind_value[attributes["kind"].(types.String).ValueString()]),
+		}
+	}
+	return result
+}
+
+// measurementsToData converts the Chirpstack measurements to the measurements
+// map of a device profile. If ignoreUnknownKeys is set, measurements which are
+// not in current (most likely auto-detected by Chirpstack) are left out.
+func measurementsToData(measurements map[string]*api.Measurement, current types.Map, ignoreUnknownKeys bool) types.Map {
+	elements := map[string]attr.Value{}
+	for key, measurement := range measurements {
+		if _, ok := current.Elements()[key]; ignoreUnknownKeys && !ok {
+			continue
+		}
+		name := types.StringNull()
+		if measurement.Name != "" {
+			name = types.StringValue(measurement.Name)
+		}
+		elements[key] = types.ObjectValueMust(measurementAttrTypes, map[string]attr.Value{
+			"name": name,
+			"kind": types.StringValue(measurement.Kind.String()),
+		})
+	}
+	elementType := types.ObjectType{AttrTypes: measurementAttrTypes}
+	if len(elements) == 0 && current.IsNull() {
+		return types.MapNull(elementType)
+	}
+	return types.MapValueMust(elementType, elements)
+}
+
+// keepUnmanagedMeasurements adds the measurements of the existing device
+// profile which have never been managed by Terraform, so that auto-detected
+// measurements are not removed when updating the device profile.
+func keepUnmanagedMeasurements(deviceProfile, existing *api.DeviceProfile, prior types.Map) {
+	for key, measurement := range existing.Measurements {
+		if _, ok := deviceProfile.Measurements[key]; ok {
+			continue
+		}
+		if _, ok := prior.Elements()[key]; ok {
+			continue
+		}
+		deviceProfile.Measurements[key] = measurement
+	}
+}
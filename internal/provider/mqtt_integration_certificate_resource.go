@@ -0,0 +1,260 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+	"github.com/halter-corp/terraform-provider-chirpstack/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MqttIntegrationCertificateResource{}
+var _ resource.ResourceWithModifyPlan = &MqttIntegrationCertificateResource{}
+
+func NewMqttIntegrationCertificateResource() resource.Resource {
+	return &MqttIntegrationCertificateResource{}
+}
+
+// MqttIntegrationCertificateResource defines the resource implementation.
+type MqttIntegrationCertificateResource struct {
+	chirpstack client.Chirpstack
+}
+
+// MqttIntegrationCertificateResourceModel describes the resource data model.
+type MqttIntegrationCertificateResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	ApplicationId types.String `tfsdk:"application_id"`
+	RotateBefore  types.String `tfsdk:"rotate_before"`
+	TlsCert       types.String `tfsdk:"tls_cert"`
+	TlsKey        types.String `tfsdk:"tls_key"`
+	CaCert        types.String `tfsdk:"ca_cert"`
+	ExpiresAt     types.String `tfsdk:"expires_at"`
+}
+
+func (r *MqttIntegrationCertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mqtt_integration_certificate"
+}
+
+func (r *MqttIntegrationCertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "MQTT integration certificate resource. Issues a client certificate for the MQTT integration of an application. Chirpstack does not store issued certificates, so destroying the resource only removes it from the Terraform state and the certificate stays valid until it expires.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Certificate identifier, the hex encoded serial number of the certificate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"application_id": schema.StringAttribute{
+				MarkdownDescription: "Application ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotate_before": schema.StringAttribute{
+				MarkdownDescription: "Replace the certificate when it expires within this duration, e.g. `720h`. The certificate is only replaced when Terraform plans after that point. If not set, the certificate is not replaced before it expires.",
+				Optional:            true,
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"tls_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tls_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of the client certificate.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ca_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificate of the MQTT broker.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "Expiration time of the certificate (RFC3339).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *MqttIntegrationCertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	chirpstack, ok := req.ProviderData.(client.Chirpstack)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Chirpstack, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.chirpstack = chirpstack
+}
+
+func (r *MqttIntegrationCertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to rotate when the resource is created or destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var rotateBefore, expiresAt types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("rotate_before"), &rotateBefore)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("expires_at"), &expiresAt)...)
+	if resp.Diagnostics.HasError() || rotateBefore.IsNull() || rotateBefore.IsUnknown() {
+		return
+	}
+
+	// Invalid durations are reported by the attribute validator.
+	duration, err := time.ParseDuration(rotateBefore.ValueString())
+	if err != nil {
+		return
+	}
+	expires, err := time.Parse(time.RFC3339, expiresAt.ValueString())
+	if err != nil || time.Now().Add(duration).Before(expires) {
+		return
+	}
+
+	// The certificate is about to expire, so a new certificate is issued.
+	for _, attribute := range []string{"id", "tls_cert", "tls_key", "ca_cert", "expires_at"} {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attribute), types.StringUnknown())...)
+	}
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expires_at"))
+}
+
+// certificateToData sets the issued certificate.
+func certificateToData(certificate *api.GenerateMqttIntegrationClientCertificateResponse, data *MqttIntegrationCertificateResourceModel) error {
+	block, _ := pem.Decode([]byte(certificate.TlsCert))
+	if block == nil {
+		return errors.New("no PEM encoded certificate found")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("unable to parse certificate: %w", err)
+	}
+
+	expiresAt := cert.NotAfter
+	if certificate.ExpiresAt != nil {
+		expiresAt = certificate.ExpiresAt.AsTime()
+	}
+	data.Id = types.StringValue(cert.SerialNumber.Text(16))
+	data.TlsCert = types.StringValue(certificate.TlsCert)
+	data.TlsKey = types.StringValue(certificate.TlsKey)
+	data.CaCert = types.StringValue(certificate.CaCert)
+	data.ExpiresAt = types.StringValue(expiresAt.UTC().Format(time.RFC3339))
+	return nil
+}
+
+func (r *MqttIntegrationCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MqttIntegrationCertificateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certificate, err := r.chirpstack.GenerateMqttIntegrationCertificate(ctx, data.ApplicationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to generate mqtt integration certificate, got error: %s", err))
+		return
+	}
+	if err := certificateToData(certificate, &data); err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read mqtt integration certificate, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MqttIntegrationCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MqttIntegrationCertificateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Chirpstack does not store the certificate, only the application can be
+	// checked.
+	_, err := r.chirpstack.GetApplication(ctx, data.ApplicationId.ValueString())
+	// The application has been deleted outside of Terraform.
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read application, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MqttIntegrationCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only rotate_before can be updated, which is not sent to Chirpstack.
+	var data MqttIntegrationCertificateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MqttIntegrationCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Chirpstack can not revoke certificates, removing the resource from the
+	// state is all that can be done.
+	tflog.Trace(ctx, "deleted a resource")
+}
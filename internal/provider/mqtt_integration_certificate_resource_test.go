@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccMqttIntegrationCertificateResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMqttIntegrationCertificateResourceConfig("1h"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("chirpstack_mqtt_integration_certificate.test", "id"),
+					resource.TestCheckResourceAttrSet("chirpstack_mqtt_integration_certificate.test", "expires_at"),
+					resource.TestMatchResourceAttr("chirpstack_mqtt_integration_certificate.test", "tls_cert", regexp.MustCompile(`BEGIN CERTIFICATE`)),
+					resource.TestMatchResourceAttr("chirpstack_mqtt_integration_certificate.test", "tls_key", regexp.MustCompile(`PRIVATE KEY`)),
+					resource.TestMatchResourceAttr("chirpstack_mqtt_integration_certificate.test", "ca_cert", regexp.MustCompile(`BEGIN CERTIFICATE`)),
+				),
+			},
+			// Update of rotate_before keeps the certificate
+			{
+				Config: testAccMqttIntegrationCertificateResourceConfig("2h"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("chirpstack_mqtt_integration_certificate.test", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			// Rotation when the certificate expires within rotate_before
+			{
+				Config: testAccMqttIntegrationCertificateResourceConfig("876000h"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("chirpstack_mqtt_integration_certificate.test", plancheck.ResourceActionReplace),
+					},
+				},
+				ExpectNonEmptyPlan: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccMqttIntegrationCertificateResourceConfig(rotateBefore string) string {
+	return fmt.Sprintf(`
+resource "chirpstack_tenant" "test" {
+  name = "test_tenant"
+}
+resource "chirpstack_application" "test" {
+  tenant_id = chirpstack_tenant.test.id
+  name      = "test_app"
+}
+resource "chirpstack_mqtt_integration_certificate" "test" {
+  application_id = chirpstack_application.test.id
+  rotate_before  = %[1]q
+}
+`, rotateBefore)
+}
@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/halter-corp/terraform-provider-chirpstack/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MulticastGroupDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &MulticastGroupDataSource{}
+
+func NewMulticastGroupDataSource() datasource.DataSource {
+	return &MulticastGroupDataSource{}
+}
+
+// MulticastGroupDataSource defines the data source implementation.
+type MulticastGroupDataSource struct {
+	chirpstack client.Chirpstack
+}
+
+func (d *MulticastGroupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_multicast_group"
+}
+
+func (d *MulticastGroupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Multicast group data source. Looks up a multicast group by ID, or by exact name within an application.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Multicast group identifier",
+				Optional:            true,
+				Computed:            true,
+			},
+			"application_id": schema.StringAttribute{
+				MarkdownDescription: "Application ID. Required when looking up the multicast group by name.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Multicast group name",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("application_id")),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Multicast group region",
+				Computed:            true,
+			},
+			"mc_addr": schema.StringAttribute{
+				MarkdownDescription: "Multicast address (HEX encoded DevAddr)",
+				Computed:            true,
+			},
+			"mc_nwk_s_key": schema.StringAttribute{
+				MarkdownDescription: "Multicast network session key (HEX encoded AES128 key)",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"mc_app_s_key": schema.StringAttribute{
+				MarkdownDescription: "Multicast application session key (HEX encoded AES128 key)",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"f_cnt": schema.Int64Attribute{
+				MarkdownDescription: "Frame-counter",
+				Computed:            true,
+			},
+			"group_type": schema.StringAttribute{
+				MarkdownDescription: "Multicast group type. CLASS_B or CLASS_C.",
+				Computed:            true,
+			},
+			"dr": schema.Int64Attribute{
+				MarkdownDescription: "Data-rate",
+				Computed:            true,
+			},
+			"frequency": schema.Int64Attribute{
+				MarkdownDescription: "Frequency (Hz)",
+				Computed:            true,
+			},
+			"class_b_ping_slot_nb_k": schema.Int64Attribute{
+				MarkdownDescription: "Class-B ping-slots per beacon period (only for Class-B). The actual number of ping-slots per beacon period equals to 2^k.",
+				Computed:            true,
+			},
+			"class_b_ping_slot_period": schema.Int64Attribute{
+				MarkdownDescription: "Ping-slot period (only for Class-B).",
+				DeprecationMessage:  "ChirpStack no longer uses the ping-slot period, use class_b_ping_slot_nb_k instead.",
+				Computed:            true,
+			},
+			"class_c_scheduling_type": schema.StringAttribute{
+				MarkdownDescription: "Scheduling type (only for Class-C). DELAY or GPS_TIME.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *MulticastGroupDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
+func (d *MulticastGroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	chirpstack, ok := req.ProviderData.(client.Chirpstack)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Chirpstack, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.chirpstack = chirpstack
+}
+
+func (d *MulticastGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MulticastGroupResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.Id.ValueString()
+	if data.Id.IsNull() {
+		name := data.Name.ValueString()
+		var matches []string
+		for multicastGroup, err := range d.chirpstack.IterateMulticastGroups(ctx, data.ApplicationId.ValueString(), name) {
+			if err != nil {
+				resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to list multicast groups, got error: %s", err))
+				return
+			}
+			if multicastGroup.Name == name {
+				matches = append(matches, multicastGroup.Id)
+			}
+		}
+		if len(matches) != 1 {
+			resp.Diagnostics.AddError("Multicast Group Not Found", fmt.Sprintf("Expected exactly one multicast group with name %q, found %d", name, len(matches)))
+			return
+		}
+		id = matches[0]
+	}
+
+	multicastGroup, err := d.chirpstack.GetMulticastGroup(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read multicast group, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(multicastGroup.MulticastGroup.Id)
+	multicastGroupToData(multicastGroup.MulticastGroup, &data)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
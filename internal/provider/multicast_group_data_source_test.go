@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMulticastGroupDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccMulticastGroupDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.chirpstack_multicast_group.by_id", "id", "chirpstack_multicast_group.test", "id"),
+					resource.TestCheckResourceAttrPair("data.chirpstack_multicast_group.by_name", "id", "chirpstack_multicast_group.test", "id"),
+					resource.TestCheckResourceAttr("data.chirpstack_multicast_group.by_id", "group_type", "CLASS_C"),
+					resource.TestCheckResourceAttr("data.chirpstack_multicast_group.by_name", "name", "datasource-multicast-group"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMulticastGroupDataSourceConfig() string {
+	return testAccMulticastGroupResourceConfig("datasource-multicast-group", "CLASS_C") + `
+data "chirpstack_multicast_group" "by_id" {
+  id = chirpstack_multicast_group.test.id
+}
+data "chirpstack_multicast_group" "by_name" {
+  name           = chirpstack_multicast_group.test.name
+  application_id = chirpstack_application.test.id
+}
+`
+}
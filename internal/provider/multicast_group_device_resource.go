@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/halter-corp/terraform-provider-chirpstack/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MulticastGroupDeviceResource{}
+var _ resource.ResourceWithImportState = &MulticastGroupDeviceResource{}
+
+func NewMulticastGroupDeviceResource() resource.Resource {
+	return &MulticastGroupDeviceResource{}
+}
+
+// MulticastGroupDeviceResource defines the resource implementation.
+type MulticastGroupDeviceResource struct {
+	chirpstack client.Chirpstack
+}
+
+// MulticastGroupDeviceResourceModel describes the resource data model.
+type MulticastGroupDeviceResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	MulticastGroupId types.String `tfsdk:"multicast_group_id"`
+	DevEui           types.String `tfsdk:"dev_eui"`
+}
+
+func (r *MulticastGroupDeviceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_multicast_group_device"
+}
+
+func (r *MulticastGroupDeviceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Multicast group device resource. Adds a single device to a multicast group. Do not combine with `chirpstack_multicast_group_members` for the same multicast group.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Multicast group device identifier, in the form `multicast_group_id/dev_eui`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"multicast_group_id": schema.StringAttribute{
+				MarkdownDescription: "Multicast group ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dev_eui": schema.StringAttribute{
+				MarkdownDescription: "DevEUI (EUI64) of the device",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *MulticastGroupDeviceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	chirpstack, ok := req.ProviderData.(client.Chirpstack)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Chirpstack, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.chirpstack = chirpstack
+}
+
+func (r *MulticastGroupDeviceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MulticastGroupDeviceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.chirpstack.AddDeviceToMulticastGroup(ctx, data.MulticastGroupId.ValueString(), data.DevEui.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to add device to multicast group, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(data.MulticastGroupId.ValueString() + "/" + data.DevEui.ValueString())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MulticastGroupDeviceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MulticastGroupDeviceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	devices, err := r.chirpstack.ListMulticastGroupDevices(ctx, data.MulticastGroupId.ValueString())
+	// The multicast group has been deleted outside of Terraform.
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read multicast group devices, got error: %s", err))
+		return
+	}
+
+	found := false
+	for _, device := range devices {
+		if strings.EqualFold(device.DevEui, data.DevEui.ValueString()) {
+			found = true
+			break
+		}
+	}
+	// The device has been removed from the multicast group outside of Terraform.
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MulticastGroupDeviceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All attributes require replacement, so there is nothing to update.
+	var data MulticastGroupDeviceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MulticastGroupDeviceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MulticastGroupDeviceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.chirpstack.RemoveDeviceFromMulticastGroup(ctx, data.MulticastGroupId.ValueString(), data.DevEui.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to remove device from multicast group, got error: %s", err))
+		return
+	}
+}
+
+func (r *MulticastGroupDeviceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	multicastGroupId, devEui, ok := splitCompositeId(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: multicast_group_id/dev_eui. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("multicast_group_id"), multicastGroupId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dev_eui"), devEui)...)
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMulticastGroupDeviceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMulticastGroupDeviceResourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("chirpstack_multicast_group_device.test", "id"),
+					resource.TestCheckResourceAttr("chirpstack_multicast_group_device.test", "dev_eui", "010203040506070b"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "chirpstack_multicast_group_device.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccMulticastGroupDeviceResourceConfig() string {
+	return `
+resource "chirpstack_tenant" "test" {
+  name = "test_tenant"
+}
+resource "chirpstack_application" "test" {
+  tenant_id = chirpstack_tenant.test.id
+  name      = "test_app"
+}
+resource "chirpstack_device_profile" "test" {
+  tenant_id                  = chirpstack_tenant.test.id
+  name                       = "test_device_profile"
+  region                     = "AU915"
+  region_parameters_revision = "A"
+  mac_version                = "LORAWAN_1_0_3"
+  device_supports_otaa       = true
+  device_supports_class_c    = true
+}
+resource "chirpstack_device" "test" {
+  dev_eui           = "010203040506070b"
+  name              = "test_device"
+  application_id    = chirpstack_application.test.id
+  device_profile_id = chirpstack_device_profile.test.id
+}
+resource "chirpstack_multicast_group" "test" {
+  application_id = chirpstack_application.test.id
+  name           = "test_multicast_group"
+  region         = "AU915"
+  group_type     = "CLASS_C"
+  mc_addr        = "01020304"
+  mc_nwk_s_key   = "01010101010101010101010101010101"
+  mc_app_s_key   = "02020202020202020202020202020202"
+  dr             = 8
+  frequency      = 923300000
+}
+resource "chirpstack_multicast_group_device" "test" {
+  multicast_group_id = chirpstack_multicast_group.test.id
+  dev_eui            = chirpstack_device.test.dev_eui
+}
+`
+}
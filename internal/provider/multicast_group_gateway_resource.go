@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/halter-corp/terraform-provider-chirpstack/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MulticastGroupGatewayResource{}
+var _ resource.ResourceWithImportState = &MulticastGroupGatewayResource{}
+
+func NewMulticastGroupGatewayResource() resource.Resource {
+	return &MulticastGroupGatewayResource{}
+}
+
+// MulticastGroupGatewayResource defines the resource implementation.
+type MulticastGroupGatewayResource struct {
+	chirpstack client.Chirpstack
+}
+
+// MulticastGroupGatewayResourceModel describes the resource data model.
+type MulticastGroupGatewayResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	MulticastGroupId types.String `tfsdk:"multicast_group_id"`
+	GatewayId        types.String `tfsdk:"gateway_id"`
+}
+
+func (r *MulticastGroupGatewayResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_multicast_group_gateway"
+}
+
+func (r *MulticastGroupGatewayResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Multicast group gateway resource. Adds a single gateway to a multicast group. Do not combine with `chirpstack_multicast_group_members` for the same multicast group.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Multicast group gateway identifier, in the form `multicast_group_id/gateway_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"multicast_group_id": schema.StringAttribute{
+				MarkdownDescription: "Multicast group ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"gateway_id": schema.StringAttribute{
+				MarkdownDescription: "Gateway ID (EUI64)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *MulticastGroupGatewayResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	chirpstack, ok := req.ProviderData.(client.Chirpstack)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Chirpstack, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.chirpstack = chirpstack
+}
+
+func (r *MulticastGroupGatewayResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MulticastGroupGatewayResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.chirpstack.AddGatewayToMulticastGroup(ctx, data.MulticastGroupId.ValueString(), data.GatewayId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to add gateway to multicast group, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(data.MulticastGroupId.ValueString() + "/" + data.GatewayId.ValueString())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MulticastGroupGatewayResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MulticastGroupGatewayResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gateways, err := r.chirpstack.ListMulticastGroupGateways(ctx, data.MulticastGroupId.ValueString())
+	// The multicast group has been deleted outside of Terraform.
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read multicast group gateways, got error: %s", err))
+		return
+	}
+
+	found := false
+	for _, gateway := range gateways {
+		if strings.EqualFold(gateway.GatewayId, data.GatewayId.ValueString()) {
+			found = true
+			break
+		}
+	}
+	// The gateway has been removed from the multicast group outside of Terraform.
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MulticastGroupGatewayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All attributes require replacement, so there is nothing to update.
+	var data MulticastGroupGatewayResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MulticastGroupGatewayResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MulticastGroupGatewayResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.chirpstack.RemoveGatewayFromMulticastGroup(ctx, data.MulticastGroupId.ValueString(), data.GatewayId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to remove gateway from multicast group, got error: %s", err))
+		return
+	}
+}
+
+func (r *MulticastGroupGatewayResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	multicastGroupId, gatewayId, ok := splitCompositeId(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: multicast_group_id/gateway_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("multicast_group_id"), multicastGroupId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("gateway_id"), gatewayId)...)
+}
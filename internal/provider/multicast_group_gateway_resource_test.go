@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMulticastGroupGatewayResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMulticastGroupGatewayResourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("chirpstack_multicast_group_gateway.test", "id"),
+					resource.TestCheckResourceAttr("chirpstack_multicast_group_gateway.test", "gateway_id", "0102030405060709"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "chirpstack_multicast_group_gateway.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccMulticastGroupGatewayResourceConfig() string {
+	return `
+resource "chirpstack_tenant" "test" {
+  name              = "test_tenant"
+  can_have_gateways = true
+}
+resource "chirpstack_application" "test" {
+  tenant_id = chirpstack_tenant.test.id
+  name      = "test_app"
+}
+resource "chirpstack_gateway" "test" {
+  gateway_id = "0102030405060709"
+  tenant_id  = chirpstack_tenant.test.id
+  name       = "test_gateway"
+}
+resource "chirpstack_multicast_group" "test" {
+  application_id = chirpstack_application.test.id
+  name           = "test_multicast_group"
+  region         = "AU915"
+  group_type     = "CLASS_C"
+  mc_addr        = "01020304"
+  mc_nwk_s_key   = "01010101010101010101010101010101"
+  mc_app_s_key   = "02020202020202020202020202020202"
+  dr             = 8
+  frequency      = 923300000
+}
+resource "chirpstack_multicast_group_gateway" "test" {
+  multicast_group_id = chirpstack_multicast_group.test.id
+  gateway_id         = chirpstack_gateway.test.gateway_id
+}
+`
+}
@@ -0,0 +1,324 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/halter-corp/terraform-provider-chirpstack/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MulticastGroupMembersResource{}
+var _ resource.ResourceWithImportState = &MulticastGroupMembersResource{}
+
+func NewMulticastGroupMembersResource() resource.Resource {
+	return &MulticastGroupMembersResource{}
+}
+
+// MulticastGroupMembersResource defines the resource implementation.
+type MulticastGroupMembersResource struct {
+	chirpstack client.Chirpstack
+}
+
+// MulticastGroupMembersResourceModel describes the resource data model.
+type MulticastGroupMembersResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	MulticastGroupId types.String `tfsdk:"multicast_group_id"`
+	DevEuis          types.Set    `tfsdk:"dev_euis"`
+	GatewayIds       types.Set    `tfsdk:"gateway_ids"`
+}
+
+func (r *MulticastGroupMembersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_multicast_group_members"
+}
+
+func (r *MulticastGroupMembersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Multicast group members resource. Authoritatively manages the devices and gateways of a multicast group:
+members that are added outside of Terraform are removed on the next apply.
+Do not combine with ` + "`chirpstack_multicast_group_device`" + ` or ` + "`chirpstack_multicast_group_gateway`" + ` for the same multicast group.`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Multicast group members identifier. This is the same as the multicast group ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"multicast_group_id": schema.StringAttribute{
+				MarkdownDescription: "Multicast group ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dev_euis": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "DevEUIs of the devices in the multicast group",
+				Optional:            true,
+			},
+			"gateway_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "IDs of the gateways in the multicast group",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *MulticastGroupMembersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	chirpstack, ok := req.ProviderData.(client.Chirpstack)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Chirpstack, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.chirpstack = chirpstack
+}
+
+// membersDiff returns the members of desired that are missing from current
+// and the members of current that are not in desired. EUIs are compared
+// case-insensitively.
+func membersDiff(current, desired []string) (add, remove []string) {
+	for _, d := range desired {
+		if !containsFold(current, d) {
+			add = append(add, d)
+		}
+	}
+	for _, c := range current {
+		if !containsFold(desired, c) {
+			remove = append(remove, c)
+		}
+	}
+	return add, remove
+}
+
+func containsFold(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// preferKnownSpelling returns the members reported by the server, using the
+// spelling of known members where they only differ in case, so that an
+// upper-case EUI in the configuration does not show up as drift.
+func preferKnownSpelling(actual, known []string) []string {
+	result := make([]string, 0, len(actual))
+	for _, a := range actual {
+		spelling := a
+		for _, k := range known {
+			if strings.EqualFold(a, k) {
+				spelling = k
+				break
+			}
+		}
+		result = append(result, spelling)
+	}
+	return result
+}
+
+// reconcile adds and removes members of the multicast group so that it ends
+// up with the members of desired, given its current members.
+func (r *MulticastGroupMembersResource) reconcile(ctx context.Context, current, desired *MulticastGroupMembersResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	multicastGroupId := desired.MulticastGroupId.ValueString()
+
+	addDevices, removeDevices := membersDiff(stringSetFromData(current.DevEuis), stringSetFromData(desired.DevEuis))
+	for _, devEui := range removeDevices {
+		tflog.Debug(ctx, "removing device from multicast group", map[string]interface{}{"multicast_group_id": multicastGroupId, "dev_eui": devEui})
+		if err := r.chirpstack.RemoveDeviceFromMulticastGroup(ctx, multicastGroupId, devEui); err != nil {
+			diags.AddError("Chirpstack Error", fmt.Sprintf("Unable to remove device from multicast group, got error: %s", err))
+			return diags
+		}
+	}
+	for _, devEui := range addDevices {
+		tflog.Debug(ctx, "adding device to multicast group", map[string]interface{}{"multicast_group_id": multicastGroupId, "dev_eui": devEui})
+		if err := r.chirpstack.AddDeviceToMulticastGroup(ctx, multicastGroupId, devEui); err != nil {
+			diags.AddError("Chirpstack Error", fmt.Sprintf("Unable to add device to multicast group, got error: %s", err))
+			return diags
+		}
+	}
+
+	addGateways, removeGateways := membersDiff(stringSetFromData(current.GatewayIds), stringSetFromData(desired.GatewayIds))
+	for _, gatewayId := range removeGateways {
+		tflog.Debug(ctx, "removing gateway from multicast group", map[string]interface{}{"multicast_group_id": multicastGroupId, "gateway_id": gatewayId})
+		if err := r.chirpstack.RemoveGatewayFromMulticastGroup(ctx, multicastGroupId, gatewayId); err != nil {
+			diags.AddError("Chirpstack Error", fmt.Sprintf("Unable to remove gateway from multicast group, got error: %s", err))
+			return diags
+		}
+	}
+	for _, gatewayId := range addGateways {
+		tflog.Debug(ctx, "adding gateway to multicast group", map[string]interface{}{"multicast_group_id": multicastGroupId, "gateway_id": gatewayId})
+		if err := r.chirpstack.AddGatewayToMulticastGroup(ctx, multicastGroupId, gatewayId); err != nil {
+			diags.AddError("Chirpstack Error", fmt.Sprintf("Unable to add gateway to multicast group, got error: %s", err))
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// readMembers loads the current members of the multicast group into data.
+func (r *MulticastGroupMembersResource) readMembers(ctx context.Context, data *MulticastGroupMembersResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	multicastGroupId := data.MulticastGroupId.ValueString()
+
+	devices, err := r.chirpstack.ListMulticastGroupDevices(ctx, multicastGroupId)
+	if err != nil {
+		diags.AddError("Chirpstack Error", fmt.Sprintf("Unable to read multicast group devices, got error: %s", err))
+		return diags
+	}
+	devEuis := make([]string, 0, len(devices))
+	for _, device := range devices {
+		devEuis = append(devEuis, device.DevEui)
+	}
+
+	gateways, err := r.chirpstack.ListMulticastGroupGateways(ctx, multicastGroupId)
+	if err != nil {
+		diags.AddError("Chirpstack Error", fmt.Sprintf("Unable to read multicast group gateways, got error: %s", err))
+		return diags
+	}
+	gatewayIds := make([]string, 0, len(gateways))
+	for _, gateway := range gateways {
+		gatewayIds = append(gatewayIds, gateway.GatewayId)
+	}
+
+	data.Id = types.StringValue(multicastGroupId)
+	data.DevEuis = stringSetToData(preferKnownSpelling(devEuis, stringSetFromData(data.DevEuis)), data.DevEuis)
+	data.GatewayIds = stringSetToData(preferKnownSpelling(gatewayIds, stringSetFromData(data.GatewayIds)), data.GatewayIds)
+	return diags
+}
+
+func (r *MulticastGroupMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MulticastGroupMembersResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Start from the actual members, so that members which were added
+	// outside of Terraform are removed.
+	current := MulticastGroupMembersResourceModel{
+		MulticastGroupId: data.MulticastGroupId,
+		DevEuis:          types.SetNull(types.StringType),
+		GatewayIds:       types.SetNull(types.StringType),
+	}
+	resp.Diagnostics.Append(r.readMembers(ctx, &current)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, &current, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(data.MulticastGroupId.ValueString())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MulticastGroupMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MulticastGroupMembersResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The multicast group has been deleted outside of Terraform.
+	if _, err := r.chirpstack.GetMulticastGroup(ctx, data.MulticastGroupId.ValueString()); client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(r.readMembers(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MulticastGroupMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state MulticastGroupMembersResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The prior state holds the members as refreshed by Read.
+	resp.Diagnostics.Append(r.reconcile(ctx, &state, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(data.MulticastGroupId.ValueString())
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MulticastGroupMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MulticastGroupMembersResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	empty := MulticastGroupMembersResourceModel{
+		MulticastGroupId: data.MulticastGroupId,
+		DevEuis:          types.SetNull(types.StringType),
+		GatewayIds:       types.SetNull(types.StringType),
+	}
+	resp.Diagnostics.Append(r.reconcile(ctx, &data, &empty)...)
+}
+
+func (r *MulticastGroupMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("multicast_group_id"), req, resp)
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMulticastGroupMembersResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMulticastGroupMembersResourceConfig(`["010203040506070c", "010203040506070d"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("chirpstack_multicast_group_members.test", "id"),
+					resource.TestCheckResourceAttr("chirpstack_multicast_group_members.test", "dev_euis.#", "2"),
+					resource.TestCheckResourceAttr("chirpstack_multicast_group_members.test", "gateway_ids.#", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "chirpstack_multicast_group_members.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccMulticastGroupMembersResourceConfig(`["010203040506070d"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("chirpstack_multicast_group_members.test", "dev_euis.#", "1"),
+					resource.TestCheckTypeSetElemAttr("chirpstack_multicast_group_members.test", "dev_euis.*", "010203040506070d"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccMulticastGroupMembersResourceConfig(devEuis string) string {
+	return fmt.Sprintf(`
+resource "chirpstack_tenant" "test" {
+  name              = "test_tenant"
+  can_have_gateways = true
+}
+resource "chirpstack_application" "test" {
+  tenant_id = chirpstack_tenant.test.id
+  name      = "test_app"
+}
+resource "chirpstack_device_profile" "test" {
+  tenant_id                  = chirpstack_tenant.test.id
+  name                       = "test_device_profile"
+  region                     = "AU915"
+  region_parameters_revision = "A"
+  mac_version                = "LORAWAN_1_0_3"
+  device_supports_otaa       = true
+  device_supports_class_c    = true
+}
+resource "chirpstack_device" "test" {
+  for_each          = toset(["010203040506070c", "010203040506070d"])
+  dev_eui           = each.key
+  name              = each.key
+  application_id    = chirpstack_application.test.id
+  device_profile_id = chirpstack_device_profile.test.id
+}
+resource "chirpstack_gateway" "test" {
+  gateway_id = "010203040506070a"
+  tenant_id  = chirpstack_tenant.test.id
+  name       = "test_gateway"
+}
+resource "chirpstack_multicast_group" "test" {
+  application_id = chirpstack_application.test.id
+  name           = "test_multicast_group"
+  region         = "AU915"
+  group_type     = "CLASS_C"
+  mc_addr        = "01020304"
+  mc_nwk_s_key   = "01010101010101010101010101010101"
+  mc_app_s_key   = "02020202020202020202020202020202"
+  dr             = 8
+  frequency      = 923300000
+}
+resource "chirpstack_multicast_group_members" "test" {
+  multicast_group_id = chirpstack_multicast_group.test.id
+  dev_euis           = %[1]s
+  gateway_ids        = [chirpstack_gateway.test.gateway_id]
+
+  depends_on = [chirpstack_device.test]
+}
+`, devEuis)
+}
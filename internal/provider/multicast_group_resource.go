@@ -0,0 +1,324 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+	"github.com/chirpstack/chirpstack/api/go/v4/common"
+	"github.com/halter-corp/terraform-provider-chirpstack/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MulticastGroupResource{}
+var _ resource.ResourceWithModifyPlan = &MulticastGroupResource{}
+var _ resource.ResourceWithImportState = &MulticastGroupResource{}
+
+func NewMulticastGroupResource() resource.Resource {
+	return &MulticastGroupResource{}
+}
+
+// MulticastGroupResource defines the resource implementation.
+type MulticastGroupResource struct {
+	chirpstack client.Chirpstack
+}
+
+// MulticastGroupResourceModel describes the resource data model.
+type MulticastGroupResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	ApplicationId        types.String `tfsdk:"application_id"`
+	Name                 types.String `tfsdk:"name"`
+	Region               types.String `tfsdk:"region"`
+	McAddr               types.String `tfsdk:"mc_addr"`
+	McNwkSKey            types.String `tfsdk:"mc_nwk_s_key"`
+	McAppSKey            types.String `tfsdk:"mc_app_s_key"`
+	FCnt                 types.Int64  `tfsdk:"f_cnt"`
+	GroupType            types.String `tfsdk:"group_type"`
+	Dr                   types.Int64  `tfsdk:"dr"`
+	Frequency            types.Int64  `tfsdk:"frequency"`
+	ClassBPingSlotNbK    types.Int64  `tfsdk:"class_b_ping_slot_nb_k"`
+	ClassBPingSlotPeriod types.Int64  `tfsdk:"class_b_ping_slot_period"`
+	ClassCSchedulingType types.String `tfsdk:"class_c_scheduling_type"`
+}
+
+func (r *MulticastGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_multicast_group"
+}
+
+func (r *MulticastGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Multicast group resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Multicast group identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"application_id": schema.StringAttribute{
+				MarkdownDescription: "Application ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Multicast group name",
+				Required:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Multicast group region, e.g. `EU868`, `US915` or `AU915`. The region must be enabled on the Chirpstack server.",
+				Required:            true,
+				Validators: []validator.String{
+					enumOf(common.Region_name),
+				},
+			},
+			"mc_addr": schema.StringAttribute{
+				MarkdownDescription: "Multicast address (HEX encoded DevAddr)",
+				Required:            true,
+			},
+			"mc_nwk_s_key": schema.StringAttribute{
+				MarkdownDescription: "Multicast network session key (HEX encoded AES128 key)",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"mc_app_s_key": schema.StringAttribute{
+				MarkdownDescription: "Multicast application session key (HEX encoded AES128 key)",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"f_cnt": schema.Int64Attribute{
+				MarkdownDescription: "Frame-counter. This is only applied when the group is created or updated, changes caused by enqueued downlinks are not reported as drift.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_type": schema.StringAttribute{
+				MarkdownDescription: "Multicast group type. CLASS_B or CLASS_C.",
+				Required:            true,
+				Validators: []validator.String{
+					enumOf(api.MulticastGroupType_name),
+				},
+			},
+			"dr": schema.Int64Attribute{
+				MarkdownDescription: "Data-rate",
+				Required:            true,
+			},
+			"frequency": schema.Int64Attribute{
+				MarkdownDescription: "Frequency (Hz)",
+				Required:            true,
+			},
+			"class_b_ping_slot_nb_k": schema.Int64Attribute{
+				MarkdownDescription: "Class-B ping-slots per beacon period (only for Class-B). Valid options are 0 - 7, the actual number of ping-slots per beacon period equals to 2^k.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 7),
+				},
+			},
+			"class_b_ping_slot_period": schema.Int64Attribute{
+				MarkdownDescription: "Ping-slot period (only for Class-B).",
+				DeprecationMessage:  "ChirpStack no longer uses the ping-slot period, use class_b_ping_slot_nb_k instead.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"class_c_scheduling_type": schema.StringAttribute{
+				MarkdownDescription: "Scheduling type (only for Class-C). DELAY or GPS_TIME.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(api.MulticastGroupSchedulingType_DELAY.String()),
+				Validators: []validator.String{
+					enumOf(api.MulticastGroupSchedulingType_name),
+				},
+			},
+		},
+	}
+}
+
+func (r *MulticastGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	chirpstack, ok := req.ProviderData.(client.Chirpstack)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Chirpstack, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.chirpstack = chirpstack
+}
+
+func (r *MulticastGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlannedRegion(ctx, r.chirpstack, req, resp, false)
+}
+
+func multicastGroupFromData(data *MulticastGroupResourceModel) *api.MulticastGroup {
+	multicastGroup := &api.MulticastGroup{
+		Id:                   data.Id.ValueString(),
+		ApplicationId:        data.ApplicationId.ValueString(),
+		Name:                 data.Name.ValueString(),
+		Region:               common.Region(common.Region_value[data.Region.ValueString()]),
+		McAddr:               data.McAddr.ValueString(),
+		McNwkSKey:            data.McNwkSKey.ValueString(),
+		McAppSKey:            data.McAppSKey.ValueString(),
+		GroupType:            api.MulticastGroupType(api.MulticastGroupType_value[data.GroupType.ValueString()]),
+		Dr:                   uint32(data.Dr.ValueInt64()),
+		Frequency:            uint32(data.Frequency.ValueInt64()),
+		ClassCSchedulingType: api.MulticastGroupSchedulingType(api.MulticastGroupSchedulingType_value[data.ClassCSchedulingType.ValueString()]),
+	}
+
+	if !data.FCnt.IsNull() && !data.FCnt.IsUnknown() {
+		multicastGroup.FCnt = uint32(data.FCnt.ValueInt64())
+	}
+	if !data.ClassBPingSlotNbK.IsNull() && !data.ClassBPingSlotNbK.IsUnknown() {
+		multicastGroup.ClassBPingSlotNbK = uint32(data.ClassBPingSlotNbK.ValueInt64())
+	}
+	if !data.ClassBPingSlotPeriod.IsNull() && !data.ClassBPingSlotPeriod.IsUnknown() {
+		multicastGroup.ClassBPingSlotPeriod = uint32(data.ClassBPingSlotPeriod.ValueInt64())
+	}
+
+	return multicastGroup
+}
+
+func multicastGroupToData(multicastGroup *api.MulticastGroup, data *MulticastGroupResourceModel) {
+	data.ApplicationId = types.StringValue(multicastGroup.ApplicationId)
+	data.Name = types.StringValue(multicastGroup.Name)
+	data.Region = types.StringValue(multicastGroup.Region.String())
+	data.McAddr = types.StringValue(multicastGroup.McAddr)
+	data.McNwkSKey = types.StringValue(multicastGroup.McNwkSKey)
+	data.McAppSKey = types.StringValue(multicastGroup.McAppSKey)
+	// The frame-counter is incremented with every enqueued downlink, only
+	// take it from the server when it is not known yet (e.g. on import).
+	if data.FCnt.IsNull() || data.FCnt.IsUnknown() {
+		data.FCnt = types.Int64Value(int64(multicastGroup.FCnt))
+	}
+	data.GroupType = types.StringValue(multicastGroup.GroupType.String())
+	data.Dr = types.Int64Value(int64(multicastGroup.Dr))
+	data.Frequency = types.Int64Value(int64(multicastGroup.Frequency))
+	data.ClassBPingSlotNbK = types.Int64Value(int64(multicastGroup.ClassBPingSlotNbK))
+	data.ClassBPingSlotPeriod = types.Int64Value(int64(multicastGroup.ClassBPingSlotPeriod))
+	data.ClassCSchedulingType = types.StringValue(multicastGroup.ClassCSchedulingType.String())
+}
+
+func (r *MulticastGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MulticastGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	multicastGroup := multicastGroupFromData(&data)
+	id, err := r.chirpstack.CreateMulticastGroup(ctx, multicastGroup)
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to create multicast group, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(id)
+	multicastGroupToData(multicastGroup, &data)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MulticastGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MulticastGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	multicastGroup, err := r.chirpstack.GetMulticastGroup(ctx, data.Id.ValueString())
+	// The multicast group has been deleted outside of Terraform.
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read multicast group, got error: %s", err))
+		return
+	}
+
+	multicastGroupToData(multicastGroup.MulticastGroup, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MulticastGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MulticastGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	multicastGroup := multicastGroupFromData(&data)
+	err := r.chirpstack.UpdateMulticastGroup(ctx, multicastGroup)
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to update multicast group, got error: %s", err))
+		return
+	}
+	multicastGroupToData(multicastGroup, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MulticastGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MulticastGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.chirpstack.DeleteMulticastGroup(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to delete multicast group, got error: %s", err))
+		return
+	}
+}
+
+func (r *MulticastGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMulticastGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMulticastGroupResourceConfig("multicast-one", "CLASS_C"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("chirpstack_multicast_group.test", "id"),
+					resource.TestCheckResourceAttr("chirpstack_multicast_group.test", "name", "multicast-one"),
+					resource.TestCheckResourceAttr("chirpstack_multicast_group.test", "group_type", "CLASS_C"),
+					resource.TestCheckResourceAttr("chirpstack_multicast_group.test", "class_c_scheduling_type", "DELAY"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "chirpstack_multicast_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccMulticastGroupResourceConfig("two", "CLASS_B"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("chirpstack_multicast_group.test", "name", "two"),
+					resource.TestCheckResourceAttr("chirpstack_multicast_group.test", "group_type", "CLASS_B"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccMulticastGroupResourceConfig(multicastGroupName, groupType string) string {
+	return fmt.Sprintf(`
+resource "chirpstack_tenant" "test" {
+  name = "test_tenant"
+}
+resource "chirpstack_application" "test" {
+  tenant_id = chirpstack_tenant.test.id
+  name      = "test_app"
+}
+resource "chirpstack_multicast_group" "test" {
+  application_id = chirpstack_application.test.id
+  name           = %[1]q
+  region         = "AU915"
+  group_type     = %[2]q
+  mc_addr        = "01020304"
+  mc_nwk_s_key   = "01010101010101010101010101010101"
+  mc_app_s_key   = "02020202020202020202020202020202"
+  dr             = 8
+  frequency      = 923300000
+}
+`, multicastGroupName, groupType)
+}
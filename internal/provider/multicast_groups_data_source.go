@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/halter-corp/terraform-provider-chirpstack/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MulticastGroupsDataSource{}
+
+func NewMulticastGroupsDataSource() datasource.DataSource {
+	return &MulticastGroupsDataSource{}
+}
+
+// MulticastGroupsDataSource defines the data source implementation.
+type MulticastGroupsDataSource struct {
+	chirpstack client.Chirpstack
+}
+
+// MulticastGroupsDataSourceModel describes the data source data model.
+type MulticastGroupsDataSourceModel struct {
+	ApplicationId   types.String                         `tfsdk:"application_id"`
+	Search          types.String                         `tfsdk:"search"`
+	MulticastGroups []MulticastGroupsDataSourceItemModel `tfsdk:"multicast_groups"`
+}
+
+// MulticastGroupsDataSourceItemModel describes a single multicast group of the data source.
+type MulticastGroupsDataSourceItemModel struct {
+	Id        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Region    types.String `tfsdk:"region"`
+	GroupType types.String `tfsdk:"group_type"`
+}
+
+func (d *MulticastGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_multicast_groups"
+}
+
+func (d *MulticastGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Multicast groups data source. Lists all multicast groups of an application.",
+
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				MarkdownDescription: "Application ID",
+				Required:            true,
+			},
+			"search": schema.StringAttribute{
+				MarkdownDescription: "Only return multicast groups matching this search string.",
+				Optional:            true,
+			},
+			"multicast_groups": schema.ListNestedAttribute{
+				MarkdownDescription: "Multicast groups",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Multicast group identifier",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Multicast group name",
+							Computed:            true,
+						},
+						"region": schema.StringAttribute{
+							MarkdownDescription: "Multicast group region",
+							Computed:            true,
+						},
+						"group_type": schema.StringAttribute{
+							MarkdownDescription: "Multicast group type. CLASS_B or CLASS_C.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MulticastGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	chirpstack, ok := req.ProviderData.(client.Chirpstack)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Chirpstack, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.chirpstack = chirpstack
+}
+
+func (d *MulticastGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MulticastGroupsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.MulticastGroups = []MulticastGroupsDataSourceItemModel{}
+	for multicastGroup, err := range d.chirpstack.IterateMulticastGroups(ctx, data.ApplicationId.ValueString(), data.Search.ValueString()) {
+		if err != nil {
+			resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to list multicast groups, got error: %s", err))
+			return
+		}
+		data.MulticastGroups = append(data.MulticastGroups, MulticastGroupsDataSourceItemModel{
+			Id:        types.StringValue(multicastGroup.Id),
+			Name:      types.StringValue(multicastGroup.Name),
+			Region:    types.StringValue(multicastGroup.Region.String()),
+			GroupType: types.StringValue(multicastGroup.GroupType.String()),
+		})
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source", map[string]interface{}{"count": len(data.MulticastGroups)})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMulticastGroupsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccMulticastGroupsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.chirpstack_multicast_groups.test", "multicast_groups.#", "1"),
+					resource.TestCheckResourceAttrPair("data.chirpstack_multicast_groups.test", "multicast_groups.0.id", "chirpstack_multicast_group.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMulticastGroupsDataSourceConfig() string {
+	return testAccMulticastGroupResourceConfig("datasource-multicast-groups", "CLASS_C") + `
+data "chirpstack_multicast_groups" "test" {
+  application_id = chirpstack_multicast_group.test.application_id
+}
+`
+}
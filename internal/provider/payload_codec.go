@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// normalizeScript normalizes line endings and trailing whitespace of a payload
+// codec script, which are commonly changed by editors and by Chirpstack.
+func normalizeScript(script string) string {
+	lines := strings.Split(strings.ReplaceAll(script, "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t\r")
+	}
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n")
+}
+
+// sameScript reports whether two payload codec scripts only differ in line
+// endings or trailing whitespace.
+func sameScript(a, b string) bool {
+	return normalizeScript(a) == normalizeScript(b)
+}
+
+// scriptToData returns the Terraform value of a payload codec script, keeping
+// the current value if the scripts only differ in line endings or trailing
+// whitespace.
+func scriptToData(script string, current types.String) types.String {
+	if !current.IsNull() && !current.IsUnknown() && sameScript(script, current.ValueString()) {
+		return current
+	}
+	if script == "" && (current.IsNull() || current.IsUnknown()) {
+		return types.StringNull()
+	}
+	return types.StringValue(script)
+}
+
+var _ planmodifier.String = normalizedScriptModifier{}
+
+// normalizedScriptModifier suppresses plan differences of a payload codec
+// script which only differ in line endings or trailing whitespace. It must be
+// used on an optional and computed attribute, as the planned value may differ
+// from the configuration.
+type normalizedScriptModifier struct{}
+
+func (m normalizedScriptModifier) Description(ctx context.Context) string {
+	return "Ignores differences in line endings and trailing whitespace."
+}
+
+func (m normalizedScriptModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m normalizedScriptModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// The attribute is only computed to allow keeping the prior script, an
+	// unset script stays unset.
+	if req.ConfigValue.IsNull() {
+		resp.PlanValue = types.StringNull()
+		return
+	}
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+	if sameScript(req.StateValue.ValueString(), req.PlanValue.ValueString()) {
+		resp.PlanValue = req.StateValue
+	}
+}
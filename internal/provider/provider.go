@@ -5,16 +5,24 @@ package provider
 
 import (
 	"context"
-	"net/http"
+	"fmt"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/halter-corp/terraform-provider-chirpstack/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -35,6 +43,37 @@ type ChirpstackProviderModel struct {
 	Host types.String `tfsdk:"host"`
 	Port types.Int64  `tfsdk:"port"`
 	Key  types.String `tfsdk:"key"`
+
+	Email    types.String `tfsdk:"email"`
+	Password types.String `tfsdk:"password"`
+
+	TLSMode    types.String `tfsdk:"tls_mode"`
+	CACertFile types.String `tfsdk:"ca_cert_file"`
+	CACertPEM  types.String `tfsdk:"ca_cert_pem"`
+	ServerName types.String `tfsdk:"server_name"`
+	ClientCert types.String `tfsdk:"client_cert"`
+	ClientKey  types.String `tfsdk:"client_key"`
+
+	Retry *ChirpstackProviderRetryModel `tfsdk:"retry"`
+
+	MaxRequestsPerSecond  types.Float64 `tfsdk:"max_requests_per_second"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+
+	DefaultTags *ChirpstackProviderDefaultTagsModel `tfsdk:"default_tags"`
+}
+
+// ChirpstackProviderDefaultTagsModel describes the default tags of the provider.
+type ChirpstackProviderDefaultTagsModel struct {
+	Tags types.Map `tfsdk:"tags"`
+}
+
+// ChirpstackProviderRetryModel describes the retry policy of the provider.
+type ChirpstackProviderRetryModel struct {
+	MaxAttempts    types.Int64  `tfsdk:"max_attempts"`
+	InitialBackoff types.String `tfsdk:"initial_backoff"`
+	MaxBackoff     types.String `tfsdk:"max_backoff"`
+	CallTimeout    types.String `tfsdk:"call_timeout"`
+	RetryableCodes types.List   `tfsdk:"retryable_codes"`
 }
 
 func (p *ChirpstackProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -58,6 +97,116 @@ func (p *ChirpstackProvider) Schema(ctx context.Context, req provider.SchemaRequ
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email of a Chirpstack user to log in as, instead of using an api key. Conflicts with `key`, and is ignored when only set with the environment variable while an api key is configured. Useful to bootstrap a fresh installation that has no api keys yet. Can also be set with the `CHIRPSTACK_EMAIL` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("key")),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password of the Chirpstack user given by `email`. Can also be set with the `CHIRPSTACK_PASSWORD` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("key")),
+				},
+			},
+			"tls_mode": schema.StringAttribute{
+				MarkdownDescription: "How the connection to Chirpstack is secured. `verify` (default) uses TLS and verifies the server certificate, `insecure` uses TLS without verifying the server certificate and `plaintext` does not use TLS. Earlier versions of the provider did not verify the server certificate, so deployments with a self-signed certificate must set `tls_mode = \"insecure\"` or `ca_cert_file`. Can also be set with the `CHIRPSTACK_TLS_MODE` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(client.TLSModeVerify), string(client.TLSModeInsecure), string(client.TLSModePlaintext)),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM encoded CA certificate used to verify the server certificate, instead of the system certificate pool. Can also be set with the `CHIRPSTACK_CA_CERT_FILE` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_pem")),
+				},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificate used to verify the server certificate, instead of the system certificate pool.",
+				Optional:            true,
+			},
+			"server_name": schema.StringAttribute{
+				MarkdownDescription: "Server name used to verify the server certificate, when it differs from `host`. Can also be set with the `CHIRPSTACK_SERVER_NAME` environment variable.",
+				Optional:            true,
+			},
+			"client_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate for mutual TLS.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key")),
+				},
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of the client certificate for mutual TLS.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert")),
+				},
+			},
+			"max_requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Maximum number of Chirpstack calls per second, shared by all resources and data sources. Every retry counts as a call. Unlimited when not set.",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0.01),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of Chirpstack calls in flight, shared by all resources and data sources. Unlimited when not set.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"default_tags": schema.SingleNestedAttribute{
+				MarkdownDescription: "Tags added to every tenant, application, device profile, device and gateway managed by the provider. Tags set on a resource take precedence. The effective tags of a resource are exposed in its `tags_all` attribute.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"tags": schema.MapAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "Default tags",
+						Optional:            true,
+					},
+				},
+			},
+			"retry": schema.SingleNestedAttribute{
+				MarkdownDescription: "Retry policy applied to every Chirpstack call.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"max_attempts": schema.Int64Attribute{
+						MarkdownDescription: fmt.Sprintf("Total number of attempts per call, including the first one. Set to 1 to disable retries. Defaults to %d.", client.DefaultRetryPolicy.MaxAttempts),
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"initial_backoff": schema.StringAttribute{
+						MarkdownDescription: fmt.Sprintf("Delay before the first retry, e.g. `500ms`. The delay doubles with every further retry and is jittered. Defaults to `%s`.", client.DefaultRetryPolicy.InitialBackoff),
+						Optional:            true,
+					},
+					"max_backoff": schema.StringAttribute{
+						MarkdownDescription: fmt.Sprintf("Maximum delay between retries. Defaults to `%s`.", client.DefaultRetryPolicy.MaxBackoff),
+						Optional:            true,
+					},
+					"call_timeout": schema.StringAttribute{
+						MarkdownDescription: fmt.Sprintf("Deadline of a single attempt. Set to `0s` to disable. Defaults to `%s`.", client.DefaultRetryPolicy.CallTimeout),
+						Optional:            true,
+					},
+					"retryable_codes": schema.ListAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "gRPC codes for which a call is retried, e.g. `UNAVAILABLE`. Defaults to `UNAVAILABLE` and `RESOURCE_EXHAUSTED`.",
+						Optional:            true,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(stringvalidator.OneOf(client.CodeNames()...)),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -74,10 +223,6 @@ func (p *ChirpstackProvider) Configure(ctx context.Context, req provider.Configu
 	// Configuration values are now available.
 	// if data.Endpoint.IsNull() { /* ... */ }
 
-	// Example client configuration for data sources and resources
-	defaultClient := http.DefaultClient
-	resp.DataSourceData = defaultClient
-
 	host := data.Host.ValueString()
 	if host == "" {
 		host = os.Getenv("CHIRPSTACK_HOST")
@@ -86,18 +231,134 @@ func (p *ChirpstackProvider) Configure(ctx context.Context, req provider.Configu
 	if port == 0 {
 		port, _ = strconv.Atoi(os.Getenv("CHIRPSTACK_PORT"))
 	}
-	key := data.Key.ValueString()
-	if key == "" {
-		key = os.Getenv("CHIRPSTACK_KEY")
+	credentials := client.Credentials{
+		APIKey:   data.Key.ValueString(),
+		Email:    data.Email.ValueString(),
+		Password: data.Password.ValueString(),
+	}
+	if credentials.Email == "" {
+		credentials.Email = os.Getenv("CHIRPSTACK_EMAIL")
+	}
+	if credentials.Password == "" {
+		credentials.Password = os.Getenv("CHIRPSTACK_PASSWORD")
+	}
+	// An explicitly configured api key or email wins over the environment.
+	if credentials.APIKey == "" && data.Email.IsNull() {
+		credentials.APIKey = os.Getenv("CHIRPSTACK_KEY")
+	}
+	if credentials.APIKey != "" {
+		credentials.Email = ""
+		credentials.Password = ""
+	}
+	if credentials.Email != "" && credentials.Password == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"Missing Password",
+			"A password is required to log in to Chirpstack with an email. Set the password attribute or the CHIRPSTACK_PASSWORD environment variable.",
+		)
+		return
+	}
+
+	tlsConfig := client.TLSConfig{
+		Mode:       client.TLSMode(data.TLSMode.ValueString()),
+		CACertFile: data.CACertFile.ValueString(),
+		CACertPEM:  data.CACertPEM.ValueString(),
+		ServerName: data.ServerName.ValueString(),
+		ClientCert: data.ClientCert.ValueString(),
+		ClientKey:  data.ClientKey.ValueString(),
+	}
+	if tlsConfig.Mode == "" {
+		tlsConfig.Mode = client.TLSMode(os.Getenv("CHIRPSTACK_TLS_MODE"))
+	}
+	if tlsConfig.CACertFile == "" && tlsConfig.CACertPEM == "" {
+		tlsConfig.CACertFile = os.Getenv("CHIRPSTACK_CA_CERT_FILE")
+	}
+	if tlsConfig.ServerName == "" {
+		tlsConfig.ServerName = os.Getenv("CHIRPSTACK_SERVER_NAME")
+	}
+
+	retryPolicy, diags := retryPolicyFromData(data.Retry)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	conn, err := client.GetChirpstackConn(ctx, host, port, key)
+	conn, err := client.GetChirpstackConn(ctx, host, port, credentials, tlsConfig, retryPolicy, client.RequestLimits{
+		MaxRequestsPerSecond:  data.MaxRequestsPerSecond.ValueFloat64(),
+		MaxConcurrentRequests: int(data.MaxConcurrentRequests.ValueInt64()),
+	})
 	if err != nil {
-		resp.Diagnostics.AddError("could not establish chirpstack connection", err.Error())
+		detail := err.Error()
+		if tlsConfig.Mode == "" || tlsConfig.Mode == client.TLSModeVerify {
+			detail += "\n\nThe server certificate is verified by default. If Chirpstack uses a self-signed certificate, set tls_mode = \"insecure\" or ca_cert_file."
+		}
+		resp.Diagnostics.AddError("could not establish chirpstack connection", detail)
 		return
 	}
 
-	resp.ResourceData = client.NewChirpstack(conn)
+	chirpstack := client.NewChirpstack(conn)
+	providerData := &chirpstackProviderData{
+		Chirpstack: chirpstack,
+	}
+	if data.DefaultTags != nil {
+		providerData.defaultTags = stringMapFromData(data.DefaultTags.Tags)
+	}
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
+}
+
+// retryPolicyFromData returns the configured retry policy, using the client
+// defaults for everything that is not set.
+func retryPolicyFromData(data *ChirpstackProviderRetryModel) (client.RetryPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	policy := client.DefaultRetryPolicy
+	if data == nil {
+		return policy, diags
+	}
+
+	if !data.MaxAttempts.IsNull() && !data.MaxAttempts.IsUnknown() {
+		policy.MaxAttempts = int(data.MaxAttempts.ValueInt64())
+	}
+	durations := []struct {
+		name  string
+		value types.String
+		dest  *time.Duration
+	}{
+		{"initial_backoff", data.InitialBackoff, &policy.InitialBackoff},
+		{"max_backoff", data.MaxBackoff, &policy.MaxBackoff},
+		{"call_timeout", data.CallTimeout, &policy.CallTimeout},
+	}
+	for _, d := range durations {
+		if d.value.IsNull() || d.value.IsUnknown() {
+			continue
+		}
+		duration, err := time.ParseDuration(d.value.ValueString())
+		if err != nil || duration < 0 {
+			diags.AddAttributeError(
+				path.Root("retry").AtName(d.name),
+				"Invalid Duration",
+				fmt.Sprintf("Expected a non-negative duration such as \"500ms\" or \"10s\", got: %q", d.value.ValueString()),
+			)
+			continue
+		}
+		*d.dest = duration
+	}
+	if !data.RetryableCodes.IsNull() && !data.RetryableCodes.IsUnknown() {
+		policy.RetryableCodes = nil
+		for _, element := range data.RetryableCodes.Elements() {
+			name, ok := element.(types.String)
+			if !ok {
+				continue
+			}
+			code, err := client.ParseCode(name.ValueString())
+			if err != nil {
+				diags.AddAttributeError(path.Root("retry").AtName("retryable_codes"), "Invalid gRPC Code", err.Error())
+				continue
+			}
+			policy.RetryableCodes = append(policy.RetryableCodes, code)
+		}
+	}
+	return policy, diags
 }
 
 func (p *ChirpstackProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -107,18 +368,44 @@ func (p *ChirpstackProvider) Resources(ctx context.Context) []func() resource.Re
 		NewApplicationResource,
 		NewDeviceProfileResource,
 		NewHttpIntegrationResource,
+		NewDeviceResource,
+		NewDeviceKeysResource,
+		NewDeviceActivationResource,
+		NewGatewayResource,
+		NewMulticastGroupResource,
+		NewMulticastGroupDeviceResource,
+		NewMulticastGroupGatewayResource,
+		NewMulticastGroupMembersResource,
+		NewApiKeyResource,
+		NewUserResource,
+		NewTenantUserResource,
+		NewRelayDeviceResource,
+		NewMqttIntegrationCertificateResource,
 	}
 }
 
 func (p *ChirpstackProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
-		NewExampleDataSource,
+		NewTenantDataSource,
+		NewApplicationDataSource,
+		NewDeviceProfileDataSource,
+		NewDeviceDataSource,
+		NewGatewayDataSource,
+		NewMulticastGroupDataSource,
+		NewTenantsDataSource,
+		NewApplicationsDataSource,
+		NewDeviceProfilesDataSource,
+		NewDevicesDataSource,
+		NewGatewaysDataSource,
+		NewMulticastGroupsDataSource,
+		NewRegionsDataSource,
 	}
 }
 
 func (p *ChirpstackProvider) Functions(ctx context.Context) []func() function.Function {
 	return []func() function.Function{
-		NewExampleFunction,
+		NewDecodeUplinkFunction,
+		NewEncodeDownlinkFunction,
 	}
 }
 
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/halter-corp/terraform-provider-chirpstack/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// validatePlannedRegion validates the planned region, and region
+// configuration if the resource has one, against the regions enabled on the
+// Chirpstack server. The regions are only validated when creating the resource
+// or changing its region, to avoid listing them on every plan.
+func validatePlannedRegion(ctx context.Context, chirpstack client.Chirpstack, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, hasRegionConfigId bool) {
+	// Nothing to do when the resource is destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	attributes := []string{"region"}
+	if hasRegionConfigId {
+		attributes = append(attributes, "region_config_id")
+	}
+	planned := map[string]types.String{}
+	changed := req.State.Raw.IsNull()
+	for _, attribute := range attributes {
+		var plan, state types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(attribute), &plan)...)
+		if !req.State.Raw.IsNull() {
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(attribute), &state)...)
+			changed = changed || !plan.Equal(state)
+		}
+		planned[attribute] = plan
+	}
+	if resp.Diagnostics.HasError() || !changed {
+		return
+	}
+
+	regionConfigId, ok := planned["region_config_id"]
+	if !ok {
+		regionConfigId = types.StringNull()
+	}
+	resp.Diagnostics.Append(validateRegion(ctx, chirpstack, planned["region"], regionConfigId)...)
+}
+
+// validateRegion reports an error if the region, or the region configuration
+// with the given ID, is not enabled in the configuration of the Chirpstack
+// server. A null regionConfigId accepts any configuration of the region.
+// Nothing is validated while either value is unknown.
+func validateRegion(ctx context.Context, chirpstack client.Chirpstack, region, regionConfigId types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	// The provider has not been configured yet, e.g. as its configuration
+	// depends on unknown values.
+	if chirpstack == nil || region.IsNull() || region.IsUnknown() || regionConfigId.IsUnknown() {
+		return diags
+	}
+
+	regions, err := chirpstack.ListRegions(ctx)
+	if err != nil {
+		diags.AddWarning("Unable To Validate Region", fmt.Sprintf("Unable to list the regions enabled on the Chirpstack server, got error: %s", err))
+		return diags
+	}
+
+	var ids, enabled []string
+	for _, item := range regions {
+		ids = append(ids, item.Id)
+		if item.Region.String() == region.ValueString() {
+			enabled = append(enabled, item.Id)
+		}
+		if !regionConfigId.IsNull() && item.Id == regionConfigId.ValueString() {
+			if item.Region.String() != region.ValueString() {
+				diags.AddAttributeError(
+					path.Root("region_config_id"),
+					"Invalid Region Configuration",
+					fmt.Sprintf("Region configuration %q is for region %s, not %s.", item.Id, item.Region, region.ValueString()),
+				)
+			}
+			return diags
+		}
+	}
+
+	if !regionConfigId.IsNull() {
+		detail := fmt.Sprintf("Region configuration %q is not enabled on the Chirpstack server, enabled region configurations: %s.", regionConfigId.ValueString(), strings.Join(ids, ", "))
+		if suggestion, ok := suggest(regionConfigId.ValueString(), ids); ok {
+			detail += fmt.Sprintf(" Did you mean %q?", suggestion)
+		}
+		diags.AddAttributeError(path.Root("region_config_id"), "Invalid Region Configuration", detail)
+		return diags
+	}
+	if len(enabled) == 0 {
+		var names []string
+		for _, item := range regions {
+			names = append(names, item.Region.String())
+		}
+		diags.AddAttributeError(
+			path.Root("region"),
+			"Region Not Enabled",
+			fmt.Sprintf("Region %s is not enabled on the Chirpstack server, enabled regions: %s.", region.ValueString(), strings.Join(names, ", ")),
+		)
+	}
+	return diags
+}
@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/halter-corp/terraform-provider-chirpstack/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RegionsDataSource{}
+
+func NewRegionsDataSource() datasource.DataSource {
+	return &RegionsDataSource{}
+}
+
+// RegionsDataSource defines the data source implementation.
+type RegionsDataSource struct {
+	chirpstack client.Chirpstack
+}
+
+// RegionsDataSourceModel describes the data source data model.
+type RegionsDataSourceModel struct {
+	Regions []RegionsDataSourceItemModel `tfsdk:"regions"`
+}
+
+// RegionsDataSourceItemModel describes a single region configuration of the
+// data source.
+type RegionsDataSourceItemModel struct {
+	Id                      types.String                    `tfsdk:"id"`
+	Region                  types.String                    `tfsdk:"region"`
+	Description             types.String                    `tfsdk:"description"`
+	UserInfo                types.String                    `tfsdk:"user_info"`
+	UplinkChannels          []RegionsDataSourceChannelModel `tfsdk:"uplink_channels"`
+	Rx1Delay                types.Int64                     `tfsdk:"rx1_delay"`
+	Rx1DrOffset             types.Int64                     `tfsdk:"rx1_dr_offset"`
+	Rx2Dr                   types.Int64                     `tfsdk:"rx2_dr"`
+	Rx2Frequency            types.Int64                     `tfsdk:"rx2_frequency"`
+	ClassBPingSlotDr        types.Int64                     `tfsdk:"class_b_ping_slot_dr"`
+	ClassBPingSlotFrequency types.Int64                     `tfsdk:"class_b_ping_slot_frequency"`
+}
+
+// RegionsDataSourceChannelModel describes an uplink channel of a region.
+type RegionsDataSourceChannelModel struct {
+	Frequency types.Int64 `tfsdk:"frequency"`
+	DrMin     types.Int64 `tfsdk:"dr_min"`
+	DrMax     types.Int64 `tfsdk:"dr_max"`
+}
+
+func (d *RegionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_regions"
+}
+
+func (d *RegionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Regions data source. Lists the regions enabled in the configuration of the Chirpstack server.",
+
+		Attributes: map[string]schema.Attribute{
+			"regions": schema.ListNestedAttribute{
+				MarkdownDescription: "Region configurations",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Region configuration ID, as used by `region_config_id` of `chirpstack_device_profile`.",
+							Computed:            true,
+						},
+						"region": schema.StringAttribute{
+							MarkdownDescription: "Region, e.g. `EU868`.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Region description",
+							Computed:            true,
+						},
+						"user_info": schema.StringAttribute{
+							MarkdownDescription: "User information",
+							Computed:            true,
+						},
+						"uplink_channels": schema.ListNestedAttribute{
+							MarkdownDescription: "Uplink channels",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"frequency": schema.Int64Attribute{
+										MarkdownDescription: "Frequency (Hz)",
+										Computed:            true,
+									},
+									"dr_min": schema.Int64Attribute{
+										MarkdownDescription: "Min. data-rate",
+										Computed:            true,
+									},
+									"dr_max": schema.Int64Attribute{
+										MarkdownDescription: "Max. data-rate",
+										Computed:            true,
+									},
+								},
+							},
+						},
+						"rx1_delay": schema.Int64Attribute{
+							MarkdownDescription: "RX1 delay (seconds)",
+							Computed:            true,
+						},
+						"rx1_dr_offset": schema.Int64Attribute{
+							MarkdownDescription: "RX1 data-rate offset",
+							Computed:            true,
+						},
+						"rx2_dr": schema.Int64Attribute{
+							MarkdownDescription: "RX2 data-rate",
+							Computed:            true,
+						},
+						"rx2_frequency": schema.Int64Attribute{
+							MarkdownDescription: "RX2 frequency (Hz)",
+							Computed:            true,
+						},
+						"class_b_ping_slot_dr": schema.Int64Attribute{
+							MarkdownDescription: "Class-B ping-slot data-rate",
+							Computed:            true,
+						},
+						"class_b_ping_slot_frequency": schema.Int64Attribute{
+							MarkdownDescription: "Class-B ping-slot frequency (Hz)",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RegionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	chirpstack, ok := req.ProviderData.(client.Chirpstack)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Chirpstack, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.chirpstack = chirpstack
+}
+
+func (d *RegionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RegionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	regions, err := d.chirpstack.ListRegions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to list regions, got error: %s", err))
+		return
+	}
+
+	data.Regions = []RegionsDataSourceItemModel{}
+	for _, item := range regions {
+		// The channels and RX parameters are not part of the list response.
+		region, err := d.chirpstack.GetRegion(ctx, item.Id)
+		if err != nil {
+			resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read region, got error: %s", err))
+			return
+		}
+		channels := []RegionsDataSourceChannelModel{}
+		for _, channel := range region.UplinkChannels {
+			channels = append(channels, RegionsDataSourceChannelModel{
+				Frequency: types.Int64Value(int64(channel.Frequency)),
+				DrMin:     types.Int64Value(int64(channel.DrMin)),
+				DrMax:     types.Int64Value(int64(channel.DrMax)),
+			})
+		}
+		data.Regions = append(data.Regions, RegionsDataSourceItemModel{
+			Id:                      types.StringValue(region.Id),
+			Region:                  types.StringValue(region.Region.String()),
+			Description:             types.StringValue(region.Description),
+			UserInfo:                types.StringValue(region.UserInfo),
+			UplinkChannels:          channels,
+			Rx1Delay:                types.Int64Value(int64(region.Rx1Delay)),
+			Rx1DrOffset:             types.Int64Value(int64(region.Rx1DrOffset)),
+			Rx2Dr:                   types.Int64Value(int64(region.Rx2Dr)),
+			Rx2Frequency:            types.Int64Value(int64(region.Rx2Frequency)),
+			ClassBPingSlotDr:        types.Int64Value(int64(region.ClassBPingSlotDr)),
+			ClassBPingSlotFrequency: types.Int64Value(int64(region.ClassBPingSlotFrequency)),
+		})
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source", map[string]interface{}{"count": len(data.Regions)})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRegionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccRegionsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.chirpstack_regions.test", "regions.*", map[string]string{
+						"region": "AU915",
+					}),
+					resource.TestCheckResourceAttrSet("data.chirpstack_regions.test", "regions.0.id"),
+					resource.TestCheckResourceAttrSet("data.chirpstack_regions.test", "regions.0.rx2_frequency"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRegionsDataSourceConfig() string {
+	return `
+data "chirpstack_regions" "test" {}
+`
+}
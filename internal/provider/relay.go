@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DeviceProfileRelayModel describes the relay (TS011) settings of a device
+// profile.
+type DeviceProfileRelayModel struct {
+	IsRelay                     types.Bool   `tfsdk:"is_relay"`
+	IsRelayEd                   types.Bool   `tfsdk:"is_relay_ed"`
+	EdRelayOnly                 types.Bool   `tfsdk:"ed_relay_only"`
+	Enabled                     types.Bool   `tfsdk:"enabled"`
+	CadPeriodicity              types.String `tfsdk:"cad_periodicity"`
+	DefaultChannelIndex         types.Int64  `tfsdk:"default_channel_index"`
+	SecondChannelFreq           types.Int64  `tfsdk:"second_channel_freq"`
+	SecondChannelDr             types.Int64  `tfsdk:"second_channel_dr"`
+	SecondChannelAckOffset      types.String `tfsdk:"second_channel_ack_offset"`
+	EdActivationMode            types.String `tfsdk:"ed_activation_mode"`
+	EdSmartEnableLevel          types.Int64  `tfsdk:"ed_smart_enable_level"`
+	EdBackOff                   types.Int64  `tfsdk:"ed_back_off"`
+	EdUplinkLimitBucketSize     types.Int64  `tfsdk:"ed_uplink_limit_bucket_size"`
+	EdUplinkLimitReloadRate     types.Int64  `tfsdk:"ed_uplink_limit_reload_rate"`
+	JoinReqLimitBucketSize      types.Int64  `tfsdk:"join_req_limit_bucket_size"`
+	JoinReqLimitReloadRate      types.Int64  `tfsdk:"join_req_limit_reload_rate"`
+	NotifyLimitBucketSize       types.Int64  `tfsdk:"notify_limit_bucket_size"`
+	NotifyLimitReloadRate       types.Int64  `tfsdk:"notify_limit_reload_rate"`
+	GlobalUplinkLimitBucketSize types.Int64  `tfsdk:"global_uplink_limit_bucket_size"`
+	GlobalUplinkLimitReloadRate types.Int64  `tfsdk:"global_uplink_limit_reload_rate"`
+	OverallLimitBucketSize      types.Int64  `tfsdk:"overall_limit_bucket_size"`
+	OverallLimitReloadRate      types.Int64  `tfsdk:"overall_limit_reload_rate"`
+}
+
+// relayFromData sets the relay settings of the device profile. Without relay
+// settings, relaying is disabled.
+func relayFromData(relay *DeviceProfileRelayModel, deviceProfile *api.DeviceProfile) {
+	if relay == nil {
+		return
+	}
+	deviceProfile.IsRelay = relay.IsRelay.ValueBool()
+	deviceProfile.IsRelayEd = relay.IsRelayEd.ValueBool()
+	deviceProfile.RelayEdRelayOnly = relay.EdRelayOnly.ValueBool()
+	deviceProfile.RelayEnabled = relay.Enabled.ValueBool()
+	deviceProfile.RelayCadPeriodicity = api.CadPeriodicity(api.CadPeriodicity_value[relay.CadPeriodicity.ValueString()])
+	deviceProfile.RelayDefaultChannelIndex = uint32(relay.DefaultChannelIndex.ValueInt64())
+	deviceProfile.RelaySecondChannelFreq = uint32(relay.SecondChannelFreq.ValueInt64())
+	deviceProfile.RelaySecondChannelDr = uint32(relay.SecondChannelDr.ValueInt64())
+	deviceProfile.RelaySecondChannelAckOffset = api.SecondChAckOffset(api.SecondChAckOffset_value[relay.SecondChannelAckOffset.ValueString()])
+	deviceProfile.RelayEdActivationMode = api.RelayModeActivation(api.RelayModeActivation_value[relay.EdActivationMode.ValueString()])
+	deviceProfile.RelayEdSmartEnableLevel = uint32(relay.EdSmartEnableLevel.ValueInt64())
+	deviceProfile.RelayEdBackOff = uint32(relay.EdBackOff.ValueInt64())
+	deviceProfile.RelayEdUplinkLimitBucketSize = uint32(relay.EdUplinkLimitBucketSize.ValueInt64())
+	deviceProfile.RelayEdUplinkLimitReloadRate = uint32(relay.EdUplinkLimitReloadRate.ValueInt64())
+	deviceProfile.RelayJoinReqLimitBucketSize = uint32(relay.JoinReqLimitBucketSize.ValueInt64())
+	deviceProfile.RelayJoinReqLimitReloadRate = uint32(relay.JoinReqLimitReloadRate.ValueInt64())
+	deviceProfile.RelayNotifyLimitBucketSize = uint32(relay.NotifyLimitBucketSize.ValueInt64())
+	deviceProfile.RelayNotifyLimitReloadRate = uint32(relay.NotifyLimitReloadRate.ValueInt64())
+	deviceProfile.RelayGlobalUplinkLimitBucketSize = uint32(relay.GlobalUplinkLimitBucketSize.ValueInt64())
+	deviceProfile.RelayGlobalUplinkLimitReloadRate = uint32(relay.GlobalUplinkLimitReloadRate.ValueInt64())
+	deviceProfile.RelayOverallLimitBucketSize = uint32(relay.OverallLimitBucketSize.ValueInt64())
+	deviceProfile.RelayOverallLimitReloadRate = uint32(relay.OverallLimitReloadRate.ValueInt64())
+}
+
+// relayToData returns the relay settings of the device profile. The settings
+// are left out if they are not configured and relaying is disabled.
+func relayToData(deviceProfile *api.DeviceProfile, current *DeviceProfileRelayModel) *DeviceProfileRelayModel {
+	if current == nil && !deviceProfile.IsRelay && !deviceProfile.IsRelayEd && !deviceProfile.RelayEnabled {
+		return nil
+	}
+	return &DeviceProfileRelayModel{
+		IsRelay:                     types.BoolValue(deviceProfile.IsRelay),
+		IsRelayEd:                   types.BoolValue(deviceProfile.IsRelayEd),
+		EdRelayOnly:                 types.BoolValue(deviceProfile.RelayEdRelayOnly),
+		Enabled:                     types.BoolValue(deviceProfile.RelayEnabled),
+		CadPeriodicity:              types.StringValue(deviceProfile.RelayCadPeriodicity.String()),
+		DefaultChannelIndex:         types.Int64Value(int64(deviceProfile.RelayDefaultChannelIndex)),
+		SecondChannelFreq:           types.Int64Value(int64(deviceProfile.RelaySecondChannelFreq)),
+		SecondChannelDr:             types.Int64Value(int64(deviceProfile.RelaySecondChannelDr)),
+		SecondChannelAckOffset:      types.StringValue(deviceProfile.RelaySecondChannelAckOffset.String()),
+		EdActivationMode:            types.StringValue(deviceProfile.RelayEdActivationMode.String()),
+		EdSmartEnableLevel:          types.Int64Value(int64(deviceProfile.RelayEdSmartEnableLevel)),
+		EdBackOff:                   types.Int64Value(int64(deviceProfile.RelayEdBackOff)),
+		EdUplinkLimitBucketSize:     types.Int64Value(int64(deviceProfile.RelayEdUplinkLimitBucketSize)),
+		EdUplinkLimitReloadRate:     types.Int64Value(int64(deviceProfile.RelayEdUplinkLimitReloadRate)),
+		JoinReqLimitBucketSize:      types.Int64Value(int64(deviceProfile.RelayJoinReqLimitBucketSize)),
+		JoinReqLimitReloadRate:      types.Int64Value(int64(deviceProfile.RelayJoinReqLimitReloadRate)),
+		NotifyLimitBucketSize:       types.Int64Value(int64(deviceProfile.RelayNotifyLimitBucketSize)),
+		NotifyLimitReloadRate:       types.Int64Value(int64(deviceProfile.RelayNotifyLimitReloadRate)),
+		GlobalUplinkLimitBucketSize: types.Int64Value(int64(deviceProfile.RelayGlobalUplinkLimitBucketSize)),
+		GlobalUplinkLimitReloadRate: types.Int64Value(int64(deviceProfile.RelayGlobalUplinkLimitReloadRate)),
+		OverallLimitBucketSize:      types.Int64Value(int64(deviceProfile.RelayOverallLimitBucketSize)),
+		OverallLimitReloadRate:      types.Int64Value(int64(deviceProfile.RelayOverallLimitReloadRate)),
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRelayDeviceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRelayDeviceResourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("chirpstack_relay_device.test", "id", "0102030405060701/0102030405060702"),
+					resource.TestCheckResourceAttr("chirpstack_device_profile.relay", "relay.is_relay", "true"),
+					resource.TestCheckResourceAttr("chirpstack_device_profile.relay", "relay.cad_periodicity", "MS_500"),
+					resource.TestCheckResourceAttr("chirpstack_device_profile.end_device", "relay.is_relay_ed", "true"),
+					resource.TestCheckResourceAttr("chirpstack_device_profile.end_device", "relay.ed_activation_mode", "DYNAMIC"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "chirpstack_relay_device.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRelayDeviceResourceConfig() string {
+	return `
+resource "chirpstack_tenant" "test" {
+  name = "test_tenant"
+}
+resource "chirpstack_application" "test" {
+  tenant_id = chirpstack_tenant.test.id
+  name      = "test_app"
+}
+resource "chirpstack_device_profile" "relay" {
+  tenant_id                  = chirpstack_tenant.test.id
+  name                       = "relay"
+  region                     = "AU915"
+  region_parameters_revision = "RP002_1_0_3"
+  mac_version                = "LORAWAN_1_0_4"
+  device_supports_otaa       = true
+  relay = {
+    is_relay        = true
+    enabled         = true
+    cad_periodicity = "MS_500"
+  }
+}
+resource "chirpstack_device_profile" "end_device" {
+  tenant_id                  = chirpstack_tenant.test.id
+  name                       = "relay_end_device"
+  region                     = "AU915"
+  region_parameters_revision = "RP002_1_0_3"
+  mac_version                = "LORAWAN_1_0_4"
+  device_supports_otaa       = true
+  relay = {
+    is_relay_ed        = true
+    ed_activation_mode = "DYNAMIC"
+  }
+}
+resource "chirpstack_device" "relay" {
+  dev_eui           = "0102030405060701"
+  name              = "relay"
+  application_id    = chirpstack_application.test.id
+  device_profile_id = chirpstack_device_profile.relay.id
+}
+resource "chirpstack_device" "end_device" {
+  dev_eui           = "0102030405060702"
+  name              = "end_device"
+  application_id    = chirpstack_application.test.id
+  device_profile_id = chirpstack_device_profile.end_device.id
+}
+resource "chirpstack_relay_device" "test" {
+  relay_dev_eui = chirpstack_device.relay.dev_eui
+  dev_eui       = chirpstack_device.end_device.dev_eui
+}
+`
+}
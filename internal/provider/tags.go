@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"maps"
+
+	"github.com/halter-corp/terraform-provider-chirpstack/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// chirpstackProviderData is passed to resources and data sources. It
+// implements client.Chirpstack, so that resources and data sources which only
+// need the client can keep asserting to that.
+type chirpstackProviderData struct {
+	client.Chirpstack
+	defaultTags defaultTags
+}
+
+// defaultTags are the provider default_tags. They are merged into the tags of
+// every taggable resource, with the tags of the resource winning.
+//
+// Taggable resources have a "tags" attribute holding their own tags and a
+// computed "tags_all" attribute holding the effective tags, which are the ones
+// sent to Chirpstack.
+type defaultTags map[string]string
+
+// defaultTagsFromProviderData returns the default tags of the provider, if any.
+func defaultTagsFromProviderData(providerData any) defaultTags {
+	if data, ok := providerData.(*chirpstackProviderData); ok {
+		return data.defaultTags
+	}
+	return nil
+}
+
+// merge returns the effective tags of a resource with the given tags.
+func (d defaultTags) merge(tags map[string]string) map[string]string {
+	merged := maps.Clone(d)
+	if merged == nil {
+		merged = map[string]string{}
+	}
+	maps.Copy(merged, tags)
+	return merged
+}
+
+// tagsToData stores the effective tags of a resource in tagsAll, and those of
+// them that do not come from the default tags in tags. A tag equal to a default
+// tag is kept in tags if it is already there, e.g. because it is configured on
+// the resource as well.
+func (d defaultTags) tagsToData(all map[string]string, tags, tagsAll *types.Map) {
+	*tagsAll = stringMapToData(all, *tagsAll)
+
+	current := stringMapFromData(*tags)
+	own := map[string]string{}
+	for k, v := range all {
+		defaultValue, isDefault := d[k]
+		if _, configured := current[k]; configured || !isDefault || defaultValue != v {
+			own[k] = v
+		}
+	}
+	*tags = stringMapToData(own, *tags)
+}
+
+// modifyPlan sets tags_all in the plan of a taggable resource to its effective
+// tags, so that changes of the default tags and tags changed outside of
+// Terraform show up in the plan.
+func (d defaultTags) modifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var tags types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagsAll := types.MapUnknown(types.StringType)
+	if isKnownMap(tags) {
+		tagsAll = stringMapToData(d.merge(stringMapFromData(tags)), types.MapNull(types.StringType))
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+}
+
+// isKnownMap reports whether the map and all of its elements are known.
+func isKnownMap(m types.Map) bool {
+	if m.IsUnknown() {
+		return false
+	}
+	for _, v := range m.Elements() {
+		if v.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/halter-corp/terraform-provider-chirpstack/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TenantDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &TenantDataSource{}
+
+func NewTenantDataSource() datasource.DataSource {
+	return &TenantDataSource{}
+}
+
+// TenantDataSource defines the data source implementation.
+type TenantDataSource struct {
+	chirpstack client.Chirpstack
+}
+
+func (d *TenantDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tenant"
+}
+
+func (d *TenantDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Tenant data source. Looks up a tenant by ID or by exact name.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Tenant identifier",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Tenant name",
+				Optional:            true,
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Tenant description",
+				Computed:            true,
+			},
+			"can_have_gateways": schema.BoolAttribute{
+				MarkdownDescription: `Can the tenant create and "own" Gateways?`,
+				Computed:            true,
+			},
+			"max_gateway_count": schema.Int64Attribute{
+				MarkdownDescription: "Max. gateway count for tenant. When set to 0, the tenant can have unlimited gateways.",
+				Computed:            true,
+			},
+			"max_device_count": schema.Int64Attribute{
+				MarkdownDescription: "Max. device count for tenant. When set to 0, the tenant can have unlimited devices.",
+				Computed:            true,
+			},
+			"private_gateways_up": schema.BoolAttribute{
+				MarkdownDescription: "Private gateways (uplink). If enabled, then uplink messages will not be shared with other tenants.",
+				Computed:            true,
+			},
+			"private_gateways_down": schema.BoolAttribute{
+				MarkdownDescription: "Private gateways (downlink). If enabled, then other tenants will not be able to schedule downlink messages through the gateways of this tenant.",
+				Computed:            true,
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags (user defined).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *TenantDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
+func (d *TenantDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	chirpstack, ok := req.ProviderData.(client.Chirpstack)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Chirpstack, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.chirpstack = chirpstack
+}
+
+func (d *TenantDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TenantModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.Id.ValueString()
+	if data.Id.IsNull() {
+		name := data.Name.ValueString()
+		var matches []string
+		for tenant, err := range d.chirpstack.IterateTenants(ctx, name) {
+			if err != nil {
+				resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to list tenants, got error: %s", err))
+				return
+			}
+			if tenant.Name == name {
+				matches = append(matches, tenant.Id)
+			}
+		}
+		if len(matches) != 1 {
+			resp.Diagnostics.AddError("Tenant Not Found", fmt.Sprintf("Expected exactly one tenant with name %q, found %d", name, len(matches)))
+			return
+		}
+		id = matches[0]
+	}
+
+	tenant, err := d.chirpstack.GetTenant(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read tenant, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(tenant.Id)
+	tenantAttributesToData(tenant, &data)
+	data.Tags = stringMapToData(tenant.Tags, data.Tags)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTenantDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccTenantDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.chirpstack_tenant.by_id", "id", "chirpstack_tenant.test", "id"),
+					resource.TestCheckResourceAttrPair("data.chirpstack_tenant.by_name", "id", "chirpstack_tenant.test", "id"),
+					resource.TestCheckResourceAttr("data.chirpstack_tenant.by_id", "name", "datasource-tenant"),
+					resource.TestCheckResourceAttr("data.chirpstack_tenant.by_name", "name", "datasource-tenant"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTenantDataSourceConfig() string {
+	return testAccTenantResourceConfig("datasource-tenant") + `
+data "chirpstack_tenant" "by_id" {
+  id = chirpstack_tenant.test.id
+}
+data "chirpstack_tenant" "by_name" {
+  name = chirpstack_tenant.test.name
+}
+`
+}
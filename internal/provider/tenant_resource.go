@@ -19,6 +19,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &TenantResource{}
+var _ resource.ResourceWithModifyPlan = &TenantResource{}
 var _ resource.ResourceWithImportState = &TenantResource{}
 
 func NewTenantResource() resource.Resource {
@@ -27,11 +28,13 @@ func NewTenantResource() resource.Resource {
 
 // TenantResource defines the resource implementation.
 type TenantResource struct {
-	chirpstack client.Chirpstack
+	chirpstack  client.Chirpstack
+	defaultTags defaultTags
 }
 
-// TenantResourceModel describes the resource data model.
-type TenantResourceModel struct {
+// TenantModel describes the attributes shared by the tenant resource and data
+// source.
+type TenantModel struct {
 	Name                types.String `tfsdk:"name"`
 	Description         types.String `tfsdk:"description"`
 	Id                  types.String `tfsdk:"id"`
@@ -40,6 +43,13 @@ type TenantResourceModel struct {
 	MaxDeviceCount      types.Int64  `tfsdk:"max_device_count"`
 	PrivateGatewaysUp   types.Bool   `tfsdk:"private_gateways_up"`
 	PrivateGatewaysDown types.Bool   `tfsdk:"private_gateways_down"`
+	Tags                types.Map    `tfsdk:"tags"`
+}
+
+// TenantResourceModel describes the resource data model.
+type TenantResourceModel struct {
+	TenantModel
+	TagsAll types.Map `tfsdk:"tags_all"`
 }
 
 func (r *TenantResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -96,6 +106,16 @@ but you want to prevent other tenants from using gateway airtime.`,
 				Optional: true,
 				Computed: true,
 			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags (user defined). These tags are exposed in the event payloads or to integration. Tags are intended for aggregation and filtering.",
+				Optional:            true,
+			},
+			"tags_all": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "All tags of the resource, including those inherited from the provider `default_tags`.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -118,6 +138,11 @@ func (r *TenantResource) Configure(ctx context.Context, req resource.ConfigureRe
 	}
 
 	r.chirpstack = chirpstack
+	r.defaultTags = defaultTagsFromProviderData(req.ProviderData)
+}
+
+func (r *TenantResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.modifyPlan(ctx, req, resp)
 }
 func tenantFromData(data *TenantResourceModel) *api.Tenant {
 	tenant := api.Tenant{
@@ -142,9 +167,13 @@ func tenantFromData(data *TenantResourceModel) *api.Tenant {
 	if !data.PrivateGatewaysDown.IsNull() {
 		tenant.PrivateGatewaysDown = data.PrivateGatewaysDown.ValueBool()
 	}
+	tenant.Tags = stringMapFromData(data.TagsAll)
 	return &tenant
 }
-func tenantToData(tenant *api.Tenant, data *TenantResourceModel) {
+
+// tenantAttributesToData sets the attributes shared by the tenant resource and
+// data source, except for the tags.
+func tenantAttributesToData(tenant *api.Tenant, data *TenantModel) {
 	data.Name = types.StringValue(tenant.Name)
 	if tenant.Description != "" {
 		data.Description = types.StringValue(tenant.Description)
@@ -156,6 +185,11 @@ func tenantToData(tenant *api.Tenant, data *TenantResourceModel) {
 	data.PrivateGatewaysDown = types.BoolValue(tenant.PrivateGatewaysDown)
 }
 
+func tenantToData(tenant *api.Tenant, data *TenantResourceModel, defaults defaultTags) {
+	tenantAttributesToData(tenant, &data.TenantModel)
+	defaults.tagsToData(tenant.Tags, &data.Tags, &data.TagsAll)
+}
+
 func (r *TenantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data TenantResourceModel
 
@@ -183,7 +217,7 @@ func (r *TenantResource) Create(ctx context.Context, req resource.CreateRequest,
 	// For the purposes of this tenant code, hardcoding a response value to
 	// save into the Terraform state.
 	data.Id = types.StringValue(id)
-	tenantToData(tenant, &data)
+	tenantToData(tenant, &data, r.defaultTags)
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
@@ -211,12 +245,17 @@ func (r *TenantResource) Read(ctx context.Context, req resource.ReadRequest, res
 	//     return
 	// }
 	tenant, err := r.chirpstack.GetTenant(ctx, data.Id.ValueString())
+	// The tenant has been deleted outside of Terraform.
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read tenant, got error: %s", err))
 		return
 	}
 
-	tenantToData(tenant, &data)
+	tenantToData(tenant, &data, r.defaultTags)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -245,7 +284,7 @@ func (r *TenantResource) Update(ctx context.Context, req resource.UpdateRequest,
 		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to update tenant, got error: %s", err))
 		return
 	}
-	tenantToData(tenant, &data)
+	tenantToData(tenant, &data, r.defaultTags)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
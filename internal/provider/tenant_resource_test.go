@@ -22,6 +22,7 @@ func TestAccTenantResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("chirpstack_tenant.test", "id"),
 					resource.TestCheckResourceAttr("chirpstack_tenant.test", "name", "one"),
 					resource.TestCheckResourceAttr("chirpstack_tenant.test", "can_have_gateways", "true"),
+					resource.TestCheckResourceAttr("chirpstack_tenant.test", "tags.billing", "internal"),
 				),
 			},
 			// ImportState testing
@@ -47,6 +48,57 @@ func testAccTenantResourceConfig(name string) string {
 resource "chirpstack_tenant" "test" {
   name = %[1]q
   can_have_gateways = true
+  tags = {
+    billing = "internal"
+  }
 }
 `, name)
 }
+
+func TestAccTenantResource_defaultTags(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTenantResourceDefaultTagsConfig("platform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("chirpstack_tenant.test", "tags.%", "1"),
+					resource.TestCheckResourceAttr("chirpstack_tenant.test", "tags.billing", "internal"),
+					resource.TestCheckResourceAttr("chirpstack_tenant.test", "tags_all.%", "2"),
+					resource.TestCheckResourceAttr("chirpstack_tenant.test", "tags_all.billing", "internal"),
+					resource.TestCheckResourceAttr("chirpstack_tenant.test", "tags_all.owner", "platform"),
+				),
+			},
+			// Changing the default tags updates the resource
+			{
+				Config: testAccTenantResourceDefaultTagsConfig("network"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("chirpstack_tenant.test", "tags.%", "1"),
+					resource.TestCheckResourceAttr("chirpstack_tenant.test", "tags_all.owner", "network"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTenantResourceDefaultTagsConfig(owner string) string {
+	return fmt.Sprintf(`
+provider "chirpstack" {
+  default_tags = {
+    tags = {
+      owner   = %[1]q
+      billing = "shared"
+    }
+  }
+}
+resource "chirpstack_tenant" "test" {
+  name = "test_tenant"
+  tags = {
+    billing = "internal"
+  }
+}
+`, owner)
+}
@@ -0,0 +1,240 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+	"github.com/halter-corp/terraform-provider-chirpstack/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TenantUserResource{}
+var _ resource.ResourceWithImportState = &TenantUserResource{}
+
+func NewTenantUserResource() resource.Resource {
+	return &TenantUserResource{}
+}
+
+// TenantUserResource defines the resource implementation.
+type TenantUserResource struct {
+	chirpstack client.Chirpstack
+}
+
+// TenantUserResourceModel describes the resource data model.
+type TenantUserResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	TenantId       types.String `tfsdk:"tenant_id"`
+	UserId         types.String `tfsdk:"user_id"`
+	IsAdmin        types.Bool   `tfsdk:"is_admin"`
+	IsDeviceAdmin  types.Bool   `tfsdk:"is_device_admin"`
+	IsGatewayAdmin types.Bool   `tfsdk:"is_gateway_admin"`
+}
+
+func (r *TenantUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tenant_user"
+}
+
+func (r *TenantUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Tenant user resource. Gives a user access to a tenant.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Tenant user identifier, in the form `tenant_id/user_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "Tenant ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "User ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_admin": schema.BoolAttribute{
+				MarkdownDescription: "User is admin within the context of the tenant. There is no need to set `is_device_admin` and `is_gateway_admin` as well.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"is_device_admin": schema.BoolAttribute{
+				MarkdownDescription: "User is able to modify device related resources (applications, device profiles, devices, multicast groups).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"is_gateway_admin": schema.BoolAttribute{
+				MarkdownDescription: "User is able to modify gateways.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *TenantUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	chirpstack, ok := req.ProviderData.(client.Chirpstack)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Chirpstack, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.chirpstack = chirpstack
+}
+
+func tenantUserFromData(data *TenantUserResourceModel) *api.TenantUser {
+	return &api.TenantUser{
+		TenantId:       data.TenantId.ValueString(),
+		UserId:         data.UserId.ValueString(),
+		IsAdmin:        data.IsAdmin.ValueBool(),
+		IsDeviceAdmin:  data.IsDeviceAdmin.ValueBool(),
+		IsGatewayAdmin: data.IsGatewayAdmin.ValueBool(),
+	}
+}
+
+func tenantUserToData(tenantUser *api.TenantUser, data *TenantUserResourceModel) {
+	data.IsAdmin = types.BoolValue(tenantUser.IsAdmin)
+	data.IsDeviceAdmin = types.BoolValue(tenantUser.IsDeviceAdmin)
+	data.IsGatewayAdmin = types.BoolValue(tenantUser.IsGatewayAdmin)
+}
+
+func (r *TenantUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TenantUserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tenantUser := tenantUserFromData(&data)
+	err := r.chirpstack.AddTenantUser(ctx, tenantUser)
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to add user to tenant, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(data.TenantId.ValueString() + "/" + data.UserId.ValueString())
+	tenantUserToData(tenantUser, &data)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TenantUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TenantUserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tenantUser, err := r.chirpstack.GetTenantUser(ctx, data.TenantId.ValueString(), data.UserId.ValueString())
+	// The user has been removed from the tenant outside of Terraform.
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read tenant user, got error: %s", err))
+		return
+	}
+
+	tenantUserToData(tenantUser, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TenantUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TenantUserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tenantUser := tenantUserFromData(&data)
+	err := r.chirpstack.UpdateTenantUser(ctx, tenantUser)
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to update tenant user, got error: %s", err))
+		return
+	}
+	tenantUserToData(tenantUser, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TenantUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TenantUserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.chirpstack.DeleteTenantUser(ctx, data.TenantId.ValueString(), data.UserId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to remove user from tenant, got error: %s", err))
+		return
+	}
+}
+
+func (r *TenantUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tenantId, userId, ok := splitCompositeId(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: tenant_id/user_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tenant_id"), tenantId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userId)...)
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTenantUserResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTenantUserResourceConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("chirpstack_tenant_user.test", "id"),
+					resource.TestCheckResourceAttr("chirpstack_tenant_user.test", "is_admin", "false"),
+					resource.TestCheckResourceAttr("chirpstack_tenant_user.test", "is_device_admin", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "chirpstack_tenant_user.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccTenantUserResourceConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("chirpstack_tenant_user.test", "is_admin", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTenantUserResourceConfig(isAdmin bool) string {
+	return fmt.Sprintf(`
+resource "chirpstack_tenant" "test" {
+  name = "test_tenant"
+}
+resource "chirpstack_user" "test" {
+  email    = "test_tenant_user@example.com"
+  password = "test_password"
+}
+resource "chirpstack_tenant_user" "test" {
+  tenant_id       = chirpstack_tenant.test.id
+  user_id         = chirpstack_user.test.id
+  is_admin        = %[1]t
+  is_device_admin = true
+}
+`, isAdmin)
+}
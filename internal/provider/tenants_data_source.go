@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/halter-corp/terraform-provider-chirpstack/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TenantsDataSource{}
+
+func NewTenantsDataSource() datasource.DataSource {
+	return &TenantsDataSource{}
+}
+
+// TenantsDataSource defines the data source implementation.
+type TenantsDataSource struct {
+	chirpstack client.Chirpstack
+}
+
+// TenantsDataSourceModel describes the data source data model.
+type TenantsDataSourceModel struct {
+	Search  types.String                 `tfsdk:"search"`
+	Tags    types.Map                    `tfsdk:"tags"`
+	Tenants []TenantsDataSourceItemModel `tfsdk:"tenants"`
+}
+
+// TenantsDataSourceItemModel describes a single tenant of the data source.
+type TenantsDataSourceItemModel struct {
+	Id                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	CanHaveGateways     types.Bool   `tfsdk:"can_have_gateways"`
+	MaxGatewayCount     types.Int64  `tfsdk:"max_gateway_count"`
+	MaxDeviceCount      types.Int64  `tfsdk:"max_device_count"`
+	PrivateGatewaysUp   types.Bool   `tfsdk:"private_gateways_up"`
+	PrivateGatewaysDown types.Bool   `tfsdk:"private_gateways_down"`
+}
+
+func (d *TenantsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tenants"
+}
+
+func (d *TenantsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Tenants data source. Lists all tenants visible to the API key.",
+
+		Attributes: map[string]schema.Attribute{
+			"search": schema.StringAttribute{
+				MarkdownDescription: "Only return tenants matching this search string.",
+				Optional:            true,
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only return tenants that have all of these tags. Filtering on tags requires reading every tenant returned by the search.",
+				Optional:            true,
+			},
+			"tenants": schema.ListNestedAttribute{
+				MarkdownDescription: "Tenants",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Tenant identifier",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Tenant name",
+							Computed:            true,
+						},
+						"can_have_gateways": schema.BoolAttribute{
+							MarkdownDescription: `Can the tenant create and "own" Gateways?`,
+							Computed:            true,
+						},
+						"max_gateway_count": schema.Int64Attribute{
+							MarkdownDescription: "Max. gateway count for tenant. 0 means unlimited.",
+							Computed:            true,
+						},
+						"max_device_count": schema.Int64Attribute{
+							MarkdownDescription: "Max. device count for tenant. 0 means unlimited.",
+							Computed:            true,
+						},
+						"private_gateways_up": schema.BoolAttribute{
+							MarkdownDescription: "Private gateways (uplink).",
+							Computed:            true,
+						},
+						"private_gateways_down": schema.BoolAttribute{
+							MarkdownDescription: "Private gateways (downlink).",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TenantsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	chirpstack, ok := req.ProviderData.(client.Chirpstack)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Chirpstack, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.chirpstack = chirpstack
+}
+
+func (d *TenantsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TenantsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags := stringMapFromData(data.Tags)
+	data.Tenants = []TenantsDataSourceItemModel{}
+	for tenant, err := range d.chirpstack.IterateTenants(ctx, data.Search.ValueString()) {
+		if err != nil {
+			resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to list tenants, got error: %s", err))
+			return
+		}
+		// Tags are not part of the list response, so they can only be
+		// filtered on by reading the tenant.
+		if len(tags) != 0 {
+			fullTenant, err := d.chirpstack.GetTenant(ctx, tenant.Id)
+			if err != nil {
+				resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read tenant, got error: %s", err))
+				return
+			}
+			if !matchesTags(fullTenant.Tags, tags) {
+				continue
+			}
+		}
+		data.Tenants = append(data.Tenants, TenantsDataSourceItemModel{
+			Id:                  types.StringValue(tenant.Id),
+			Name:                types.StringValue(tenant.Name),
+			CanHaveGateways:     types.BoolValue(tenant.CanHaveGateways),
+			MaxGatewayCount:     types.Int64Value(int64(tenant.MaxGatewayCount)),
+			MaxDeviceCount:      types.Int64Value(int64(tenant.MaxDeviceCount)),
+			PrivateGatewaysUp:   types.BoolValue(tenant.PrivateGatewaysUp),
+			PrivateGatewaysDown: types.BoolValue(tenant.PrivateGatewaysDown),
+		})
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source", map[string]interface{}{"count": len(data.Tenants)})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTenantsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccTenantsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.chirpstack_tenants.test", "tenants.#", "1"),
+					resource.TestCheckResourceAttrPair("data.chirpstack_tenants.test", "tenants.0.id", "chirpstack_tenant.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTenantsDataSourceConfig() string {
+	return testAccTenantResourceConfig("datasource-tenants") + `
+data "chirpstack_tenants" "test" {
+  search = chirpstack_tenant.test.name
+}
+`
+}
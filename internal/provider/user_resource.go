@@ -0,0 +1,257 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+	"github.com/halter-corp/terraform-provider-chirpstack/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UserResource{}
+var _ resource.ResourceWithImportState = &UserResource{}
+
+func NewUserResource() resource.Resource {
+	return &UserResource{}
+}
+
+// UserResource defines the resource implementation.
+type UserResource struct {
+	chirpstack client.Chirpstack
+}
+
+// UserResourceModel describes the resource data model.
+type UserResourceModel struct {
+	Id       types.String `tfsdk:"id"`
+	Email    types.String `tfsdk:"email"`
+	IsAdmin  types.Bool   `tfsdk:"is_admin"`
+	IsActive types.Bool   `tfsdk:"is_active"`
+	Note     types.String `tfsdk:"note"`
+	Password types.String `tfsdk:"password"`
+	// PasswordVersion triggers setting password, which is write-only and
+	// therefore never in the plan or state.
+	PasswordVersion types.Int64 `tfsdk:"password_version"`
+}
+
+func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "User resource. Use `chirpstack_tenant_user` to give the user access to a tenant.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "User identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email of the user, used to log in.",
+				Required:            true,
+			},
+			"is_admin": schema.BoolAttribute{
+				MarkdownDescription: "Make the user a global administrator.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"is_active": schema.BoolAttribute{
+				MarkdownDescription: "Set to false to disable the user.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"note": schema.StringAttribute{
+				MarkdownDescription: "Optional note to store with the user.",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password of the user. The password is write-only: it is not stored in the Terraform state and never read back from Chirpstack. It is set when the user is created and whenever `password_version` changes. Requires Terraform 1.11 or later.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"password_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `password`. Change it, e.g. increment it, to set a new password.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password")),
+				},
+			},
+		},
+	}
+}
+
+func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	chirpstack, ok := req.ProviderData.(client.Chirpstack)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Chirpstack, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.chirpstack = chirpstack
+}
+
+func userFromData(data *UserResourceModel) *api.User {
+	return &api.User{
+		Id:       data.Id.ValueString(),
+		Email:    data.Email.ValueString(),
+		IsAdmin:  data.IsAdmin.ValueBool(),
+		IsActive: data.IsActive.ValueBool(),
+		Note:     data.Note.ValueString(),
+	}
+}
+
+func userToData(user *api.User, data *UserResourceModel) {
+	data.Email = types.StringValue(user.Email)
+	data.IsAdmin = types.BoolValue(user.IsAdmin)
+	data.IsActive = types.BoolValue(user.IsActive)
+	if user.Note != "" {
+		data.Note = types.StringValue(user.Note)
+	}
+}
+
+func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The password is write-only, so it is only in the configuration.
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user := userFromData(&data)
+	id, err := r.chirpstack.CreateUser(ctx, user, password.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to create user, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(id)
+	userToData(user, &data)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.chirpstack.GetUser(ctx, data.Id.ValueString())
+	// The user has been deleted outside of Terraform.
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to read user, got error: %s", err))
+		return
+	}
+
+	userToData(user, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state UserResourceModel
+	var password types.String
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	// The password is write-only, so it is only in the configuration.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user := userFromData(&data)
+	err := r.chirpstack.UpdateUser(ctx, user)
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to update user, got error: %s", err))
+		return
+	}
+	if !password.IsNull() && !data.PasswordVersion.Equal(state.PasswordVersion) {
+		err = r.chirpstack.UpdateUserPassword(ctx, data.Id.ValueString(), password.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to update user password, got error: %s", err))
+			return
+		}
+	}
+	userToData(user, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.chirpstack.DeleteUser(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Chirpstack Error", fmt.Sprintf("Unable to delete user, got error: %s", err))
+		return
+	}
+}
+
+func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
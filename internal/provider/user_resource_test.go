@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccUserResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// The password is a write-only attribute.
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUserResourceConfig("first note", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("chirpstack_user.test", "id"),
+					resource.TestCheckResourceAttr("chirpstack_user.test", "email", "test_user@example.com"),
+					resource.TestCheckResourceAttr("chirpstack_user.test", "is_admin", "false"),
+					resource.TestCheckResourceAttr("chirpstack_user.test", "is_active", "true"),
+					resource.TestCheckResourceAttr("chirpstack_user.test", "note", "first note"),
+					resource.TestCheckResourceAttr("chirpstack_user.test", "password_version", "1"),
+					resource.TestCheckNoResourceAttr("chirpstack_user.test", "password"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "chirpstack_user.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password_version"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccUserResourceConfig("second note", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("chirpstack_user.test", "note", "second note"),
+					resource.TestCheckResourceAttr("chirpstack_user.test", "password_version", "2"),
+					resource.TestCheckNoResourceAttr("chirpstack_user.test", "password"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccUserResourceConfig(note string, passwordVersion int) string {
+	return fmt.Sprintf(`
+resource "chirpstack_user" "test" {
+  email            = "test_user@example.com"
+  note             = %[1]q
+  password         = "test_password_%[2]d"
+  password_version = %[2]d
+}
+`, note, passwordVersion)
+}
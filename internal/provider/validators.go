@@ -0,0 +1,220 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var _ validator.String = onlyWhenValidator{}
+var _ validator.Int64 = onlyWhenValidator{}
+var _ validator.Bool = onlyWhenValidator{}
+
+// onlyWhenValidator validates that an attribute is only set when another
+// attribute has one of the given values. A null value of the other attribute
+// can be allowed by passing a null value.
+type onlyWhenValidator struct {
+	other  path.Expression
+	values []attr.Value
+}
+
+// onlyWhen returns a validator which ensures that an attribute is only set
+// when the other attribute has one of the given values.
+func onlyWhen(other path.Expression, values ...attr.Value) onlyWhenValidator {
+	return onlyWhenValidator{other: other, values: values}
+}
+
+func (v onlyWhenValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v onlyWhenValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("Can only be set when %s is %s", v.other, v.valuesString())
+}
+
+func (v onlyWhenValidator) valuesString() string {
+	var values []string
+	for _, value := range v.values {
+		if value.IsNull() {
+			values = append(values, "not set")
+			continue
+		}
+		values = append(values, value.String())
+	}
+	return strings.Join(values, " or ")
+}
+
+func (v onlyWhenValidator) validate(ctx context.Context, config tfsdk.Config, attributePath path.Path, expression path.Expression, value attr.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if value.IsNull() || value.IsUnknown() {
+		return diags
+	}
+
+	matchedPaths, matchDiags := config.PathMatches(ctx, expression.Merge(v.other))
+	diags.Append(matchDiags...)
+	for _, matchedPath := range matchedPaths {
+		var otherValue attr.Value
+		diags.Append(config.GetAttribute(ctx, matchedPath, &otherValue)...)
+		if diags.HasError() || otherValue.IsUnknown() {
+			continue
+		}
+		allowed := false
+		for _, value := range v.values {
+			if otherValue.Equal(value) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			diags.AddAttributeError(
+				attributePath,
+				"Invalid Attribute Combination",
+				fmt.Sprintf("Attribute %q can only be set when %q is %s, got: %s.", attributePath, matchedPath, v.valuesString(), otherValue),
+			)
+		}
+	}
+	return diags
+}
+
+func (v onlyWhenValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, req.Config, req.Path, req.PathExpression, req.ConfigValue)...)
+}
+
+func (v onlyWhenValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	resp.Diagnostics.Append(v.validate(ctx, req.Config, req.Path, req.PathExpression, req.ConfigValue)...)
+}
+
+func (v onlyWhenValidator) ValidateBool(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, req.Config, req.Path, req.PathExpression, req.ConfigValue)...)
+}
+
+// enumValues returns the names of a protobuf enum, ordered by their number.
+func enumValues(names map[int32]string) []string {
+	var values []string
+	for _, number := range slices.Sorted(maps.Keys(names)) {
+		values = append(values, names[number])
+	}
+	return values
+}
+
+var _ validator.String = enumValidator{}
+
+// enumValidator validates that a string is the name of a protobuf enum value.
+// Unknown names would otherwise silently map to the zero value of the enum.
+type enumValidator struct {
+	values []string
+}
+
+// enumOf returns a validator which ensures that a string is one of the names
+// of the protobuf enum, except for the excluded names.
+func enumOf(names map[int32]string, exclude ...string) enumValidator {
+	var values []string
+	for _, value := range enumValues(names) {
+		if !slices.Contains(exclude, value) {
+			values = append(values, value)
+		}
+	}
+	return enumValidator{values: values}
+}
+
+func (v enumValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v enumValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", strings.Join(v.values, ", "))
+}
+
+func (v enumValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if slices.Contains(v.values, value) {
+		return
+	}
+	detail := fmt.Sprintf("Attribute %s value must be one of: %s, got: %q.", req.Path, strings.Join(v.values, ", "), value)
+	if suggestion, ok := suggest(value, v.values); ok {
+		detail += fmt.Sprintf(" Did you mean %q?", suggestion)
+	}
+	resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value Match", detail)
+}
+
+// suggest returns the candidate closest to the given value, if it is close
+// enough to likely be a typo. Case and separators are ignored.
+func suggest(value string, candidates []string) (string, bool) {
+	normalize := strings.NewReplacer("-", "_", ".", "_", " ", "_").Replace
+	value = strings.ToUpper(normalize(value))
+	best, bestDistance := "", -1
+	for _, candidate := range candidates {
+		distance := editDistance(value, strings.ToUpper(normalize(candidate)))
+		if bestDistance == -1 || distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+	if bestDistance == -1 || bestDistance > max(2, len(best)/3) {
+		return "", false
+	}
+	return best, true
+}
+
+// editDistance returns the Levenshtein distance between two strings.
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+var _ validator.String = durationValidator{}
+
+// durationValidator validates that a string is a non-negative Go duration.
+type durationValidator struct{}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a non-negative duration such as \"500ms\" or \"720h\""
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	duration, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || duration < 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Expected a non-negative duration such as \"500ms\" or \"720h\", got: %q", req.ConfigValue.ValueString()),
+		)
+	}
+}